@@ -0,0 +1,74 @@
+// Package bignum provides serializers for arbitrary-precision numbers:
+// *big.Int, *big.Rat and *big.Float.
+package bignum
+
+import (
+	"math/big"
+	"math/bits"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// A magnitude is encoded as varint.PositiveInt length + big-endian bytes
+// without leading zeros.
+
+func marshalMag(x *big.Int, bs []byte) (n int) {
+	l := magLen(x)
+	n = varint.PositiveInt.Marshal(l, bs)
+	if len(bs) < n+l {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	x.FillBytes(bs[n : n+l])
+	return n + l
+}
+
+// unmarshalMag returns the bytes of an encoded magnitude, without copying
+// them. The bit length is validated before the caller allocates anything.
+func unmarshalMag(bs []byte, bitLenVl com.Validator[int]) (mag []byte, n int,
+	err error,
+) {
+	l, n, err := varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if l < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if l > len(bs)-n {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	mag = bs[n : n+l]
+	if l > 0 && mag[0] == 0 {
+		mag = nil
+		err = com.ErrWrongFormat
+		return
+	}
+	if bitLenVl != nil {
+		if err = bitLenVl.Validate(bitLen(mag)); err != nil {
+			mag = nil
+			return
+		}
+	}
+	n += l
+	return
+}
+
+func sizeMag(x *big.Int) (size int) {
+	l := magLen(x)
+	return varint.PositiveInt.Size(l) + l
+}
+
+func magLen(x *big.Int) int {
+	return (x.BitLen() + 7) / 8
+}
+
+func bitLen(mag []byte) int {
+	if len(mag) == 0 {
+		return 0
+	}
+	return (len(mag)-1)*8 + bits.Len8(mag[0])
+}
@@ -0,0 +1,62 @@
+package bignum
+
+import (
+	"math/big"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	bnopts "github.com/mus-format/mus-go/options/bignum"
+)
+
+func FuzzBignum_Int(f *testing.F) {
+	f.Fuzz(func(t *testing.T, mag []byte, neg bool) {
+		v := new(big.Int).SetBytes(mag)
+		if neg {
+			v.Neg(v)
+		}
+		testSer([]*big.Int{v}, Int, intEqual, t)
+	})
+}
+
+func FuzzBignum_Rat(f *testing.F) {
+	f.Fuzz(func(t *testing.T, num int64, denom []byte) {
+		d := new(big.Int).SetBytes(denom)
+		if d.Sign() == 0 {
+			d.SetInt64(1)
+		}
+		v := new(big.Rat).SetFrac(big.NewInt(num), d)
+		testSer([]*big.Rat{v}, Rat, ratEqual, t)
+	})
+}
+
+func FuzzBignum_Float(f *testing.F) {
+	f.Fuzz(func(t *testing.T, mant int64, exp int32, prec uint16, mode uint8) {
+		v := new(big.Float).SetPrec(uint(prec)).SetMode(big.RoundingMode(mode % 6))
+		v.SetMantExp(v.SetInt64(mant), int(exp%100000))
+		testSer([]*big.Float{v}, Float, floatEqual, t)
+	})
+}
+
+func FuzzBignum_Unmarshal(f *testing.F) {
+	// We use Valid serializers to avoid OOM during fuzzing.
+	var (
+		opt = bnopts.WithBitLenValidator(com.ValidatorFn[int](
+			func(l int) (err error) {
+				if l > 8000 {
+					err = com.ErrTooLargeLength
+				}
+				return
+			}))
+		intSer   = NewValidIntSer(opt)
+		ratSer   = NewValidRatSer(opt)
+		floatSer = NewValidFloatSer(opt)
+	)
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		intSer.Unmarshal(bs)
+		intSer.Skip(bs)
+		ratSer.Unmarshal(bs)
+		ratSer.Skip(bs)
+		floatSer.Unmarshal(bs)
+		floatSer.Skip(bs)
+	})
+}
@@ -0,0 +1,266 @@
+package bignum
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	bnopts "github.com/mus-format/mus-go/options/bignum"
+	"github.com/mus-format/mus-go/test"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+func TestBignum_Int(t *testing.T) {
+	t.Run("Int serializer should succeed", func(t *testing.T) {
+		huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+		testSer([]*big.Int{
+			big.NewInt(0),
+			big.NewInt(1),
+			big.NewInt(-1),
+			big.NewInt(math.MaxInt64),
+			new(big.Int).Lsh(big.NewInt(1), 1000),
+			huge,
+		}, Int, intEqual, t)
+	})
+
+	t.Run("Int should be encoded as sign + length + magnitude",
+		func(t *testing.T) {
+			var (
+				v      = big.NewInt(-258)
+				wantBS = []byte{1, 2, 1, 2}
+				bs     = make([]byte, Int.Size(v))
+			)
+			Int.Marshal(v, bs)
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("nil should be encoded as 0", func(t *testing.T) {
+		var (
+			wantBS = []byte{0, 0}
+			bs     = make([]byte, Int.Size(nil))
+		)
+		Int.Marshal(nil, bs)
+		asserterror.EqualDeep(t, bs, wantBS)
+	})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the encoding is not canonical",
+		func(t *testing.T) {
+			for _, c := range []struct {
+				bs []byte
+				n  int
+			}{
+				{[]byte{2, 0}, 0},
+				{[]byte{1, 0}, 2},
+				{[]byte{0, 2, 0, 1}, 2},
+			} {
+				_, n, err := Int.Unmarshal(c.bs)
+				asserterror.EqualError(t, err, com.ErrWrongFormat)
+				asserterror.Equal(t, n, c.n)
+				_, err = Int.Skip(c.bs)
+				asserterror.EqualError(t, err, com.ErrWrongFormat)
+			}
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no magnitude",
+		func(t *testing.T) {
+			for _, bs := range [][]byte{{}, {0}, {0, 3, 1, 2}} {
+				_, _, err := Int.Unmarshal(bs)
+				asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			}
+		})
+
+	t.Run("Unmarshal should return ErrNegativeLength if meets a negative length",
+		func(t *testing.T) {
+			bs := append([]byte{0}, negativeLengthBs()...)
+			_, _, err := Int.Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrNegativeLength)
+		})
+
+	t.Run("If bitLenVl returns an error, valid Unmarshal and Skip should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewValidIntSer(bnopts.WithBitLenValidator(
+					com.ValidatorFn[int](validateBitLen)))
+				v  = new(big.Int).Lsh(big.NewInt(1), 64)
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			_, n, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, errTooLargeBitLen)
+			asserterror.Equal(t, n, 2)
+			_, err = ser.Skip(bs)
+			asserterror.EqualError(t, err, errTooLargeBitLen)
+			testSer([]*big.Int{big.NewInt(math.MinInt64)}, ser, intEqual, t)
+		})
+}
+
+func TestBignum_Rat(t *testing.T) {
+	t.Run("Rat serializer should succeed", func(t *testing.T) {
+		huge, _ := new(big.Rat).SetString("-1234567890123456789/98765432109876543210")
+		testSer([]*big.Rat{
+			new(big.Rat),
+			big.NewRat(1, 3),
+			big.NewRat(-22, 7),
+			big.NewRat(5, 1),
+			huge,
+		}, Rat, ratEqual, t)
+	})
+
+	t.Run("nil should be encoded as 0", func(t *testing.T) {
+		var (
+			wantBS = []byte{0, 0, 1, 1}
+			bs     = make([]byte, Rat.Size(nil))
+		)
+		Rat.Marshal(nil, bs)
+		asserterror.EqualDeep(t, bs, wantBS)
+	})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the denominator is zero",
+		func(t *testing.T) {
+			bs := []byte{0, 1, 5, 0}
+			_, n, err := Rat.Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+			asserterror.Equal(t, n, 4)
+			_, err = Rat.Skip(bs)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+		})
+
+	t.Run("If bitLenVl returns an error for the denominator, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewValidRatSer(bnopts.WithBitLenValidator(
+					com.ValidatorFn[int](validateBitLen)))
+				v  = new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 64))
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			_, _, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, errTooLargeBitLen)
+			_, err = ser.Skip(bs)
+			asserterror.EqualError(t, err, errTooLargeBitLen)
+		})
+}
+
+func TestBignum_Float(t *testing.T) {
+	t.Run("Float serializer should succeed", func(t *testing.T) {
+		pi, _, _ := big.ParseFloat("3.14159265358979323846264338327950288419716939937510",
+			10, 200, big.ToZero)
+		testSer([]*big.Float{
+			new(big.Float),
+			new(big.Float).Neg(new(big.Float).SetPrec(10)),
+			big.NewFloat(1.5),
+			big.NewFloat(-0.1),
+			big.NewFloat(math.Inf(1)),
+			big.NewFloat(math.Inf(-1)),
+			new(big.Float).SetPrec(1000).SetMode(big.AwayFromZero).SetInt64(1),
+			new(big.Float).SetMantExp(big.NewFloat(1), big.MaxExp-1),
+			new(big.Float).SetMantExp(big.NewFloat(0.5), big.MinExp),
+			pi,
+		}, Float, floatEqual, t)
+	})
+
+	t.Run("Float should be encoded as precision + header + exponent + mantissa",
+		func(t *testing.T) {
+			var (
+				v      = big.NewFloat(-1.5)
+				wantBS = []byte{53, 0b00101000, 1, 1, 3}
+				bs     = make([]byte, Float.Size(v))
+			)
+			Float.Marshal(v, bs)
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the encoding is not valid",
+		func(t *testing.T) {
+			for _, bs := range [][]byte{
+				{53, 0b01000000},
+				{53, 0b00000110},
+				{53, 0b00011000},
+				{53, 0b00001000, 1, 1, 2},
+				{1, 0b00001000, 1, 1, 3},
+				{53, 0b00001000, 1, 0},
+				{53, 0b00001000, 0xfe, 0xff, 0xff, 0xff, 0x0f, 1, 1},
+			} {
+				_, _, err := Float.Unmarshal(bs)
+				asserterror.EqualError(t, err, com.ErrWrongFormat)
+				_, err = Float.Skip(bs)
+				asserterror.EqualError(t, err, com.ErrWrongFormat)
+			}
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no header",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[*big.Float]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly([]byte{53}, Float, want, nil, t)
+		})
+
+	t.Run("If bitLenVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewValidFloatSer(bnopts.WithBitLenValidator(
+					com.ValidatorFn[int](validateBitLen)))
+				v  = new(big.Float).SetPrec(65)
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			_, n, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, errTooLargeBitLen)
+			asserterror.Equal(t, n, 1)
+			_, err = ser.Skip(bs)
+			asserterror.EqualError(t, err, errTooLargeBitLen)
+		})
+}
+
+var errTooLargeBitLen = errors.New("too large bit length")
+
+func validateBitLen(l int) (err error) {
+	if l > 64 {
+		err = errTooLargeBitLen
+	}
+	return
+}
+
+func intEqual(a, b *big.Int) bool {
+	return a.Cmp(b) == 0
+}
+
+func ratEqual(a, b *big.Rat) bool {
+	return a.Cmp(b) == 0
+}
+
+func floatEqual(a, b *big.Float) bool {
+	return a.Cmp(b) == 0 && a.Prec() == b.Prec() && a.Mode() == b.Mode() &&
+		a.Signbit() == b.Signbit()
+}
+
+func testSer[T any](cases []T, ser mus.Serializer[T], equal func(a, b T) bool,
+	t *testing.T) {
+	for i := range cases {
+		var (
+			size = ser.Size(cases[i])
+			bs   = make([]byte, size)
+		)
+		n := ser.Marshal(cases[i], bs)
+		asserterror.Equal(t, n, size)
+		v, n, err := ser.Unmarshal(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, size)
+		asserterror.Equal(t, equal(v, cases[i]), true)
+		n, err = ser.Skip(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, size)
+	}
+}
+
+func negativeLengthBs() []byte {
+	return []byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 1}
+}
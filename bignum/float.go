@@ -0,0 +1,202 @@
+package bignum
+
+import (
+	"math/big"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	bnopts "github.com/mus-format/mus-go/options/bignum"
+	"github.com/mus-format/mus-go/varint"
+)
+
+const (
+	formZero byte = iota
+	formFinite
+	formInf
+)
+
+const (
+	modeMask  byte = 0b00000111
+	formShift      = 3
+	formMask  byte = 0b00011000
+	negBit    byte = 0b00100000
+)
+
+// Float is a *big.Float serializer.
+var Float = floatSer{}
+
+// NewValidFloatSer returns a new valid *big.Float serializer. The bit length
+// validator is applied to the precision.
+func NewValidFloatSer(opts ...bnopts.SetOption) floatSer {
+	o := bnopts.Options{}
+	bnopts.Apply(opts, &o)
+	return floatSer{o.BitLenVl}
+}
+
+// floatSer encodes a *big.Float value as varint.Uint32 precision + 1 byte
+// header (rounding mode, form, sign). A finite non-zero value is followed by
+// varint.Int64 exponent + odd mantissa magnitude, such that
+// |v| = mantissa * 2^exponent. A nil value is encoded as +0.
+//
+// The accuracy of the value is not preserved.
+type floatSer struct {
+	precVl com.Validator[int]
+}
+
+// Marshal fills bs with an encoded *big.Float value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s floatSer) Marshal(v *big.Float, bs []byte) (n int) {
+	if v == nil {
+		v = zeroFloat
+	}
+	n = varint.Uint32.Marshal(uint32(v.Prec()), bs)
+	form := floatForm(v)
+	bs[n] = byte(v.Mode()) | form<<formShift
+	if v.Signbit() {
+		bs[n] |= negBit
+	}
+	n++
+	if form != formFinite {
+		return
+	}
+	mant, exp := floatMant(v)
+	n += varint.Int64.Marshal(exp, bs[n:])
+	return n + marshalMag(mant, bs[n:])
+}
+
+// Unmarshal parses an encoded *big.Float value from bs.
+//
+// In addition to the *big.Float value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice, com.ErrOverflow,
+// com.ErrNegativeLength, com.ErrWrongFormat or a precision validation error.
+func (s floatSer) Unmarshal(bs []byte) (v *big.Float, n int, err error) {
+	h, mag, exp, n, err := s.unmarshal(bs)
+	if err != nil {
+		return
+	}
+	v = new(big.Float).SetMode(h.mode).SetPrec(h.prec)
+	switch h.form {
+	case formFinite:
+		v.SetInt(new(big.Int).SetBytes(mag))
+		v.SetMantExp(v, int(exp))
+	case formInf:
+		v.SetInf(h.neg)
+		return
+	}
+	if h.neg {
+		v.Neg(v)
+	}
+	return
+}
+
+// Size returns the size of an encoded *big.Float value.
+func (s floatSer) Size(v *big.Float) (size int) {
+	if v == nil {
+		v = zeroFloat
+	}
+	size = varint.Uint32.Size(uint32(v.Prec())) + 1
+	if floatForm(v) != formFinite {
+		return
+	}
+	var (
+		minPrec = v.MinPrec()
+		exp     = int64(v.MantExp(nil)) - int64(minPrec)
+		l       = int((minPrec + 7) / 8)
+	)
+	return size + varint.Int64.Size(exp) + varint.PositiveInt.Size(l) + l
+}
+
+// Skip skips an encoded *big.Float value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrOverflow, com.ErrNegativeLength,
+// com.ErrWrongFormat or a precision validation error.
+func (s floatSer) Skip(bs []byte) (n int, err error) {
+	_, _, _, n, err = s.unmarshal(bs)
+	return
+}
+
+type floatHeader struct {
+	prec uint
+	mode big.RoundingMode
+	form byte
+	neg  bool
+}
+
+func (s floatSer) unmarshal(bs []byte) (h floatHeader, mag []byte, exp int64,
+	n int, err error) {
+	prec, n, err := varint.Uint32.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if s.precVl != nil {
+		if err = s.precVl.Validate(int(prec)); err != nil {
+			return
+		}
+	}
+	if len(bs) < n+1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	b := bs[n]
+	n++
+	h = floatHeader{
+		prec: uint(prec),
+		mode: big.RoundingMode(b & modeMask),
+		form: (b & formMask) >> formShift,
+		neg:  b&negBit != 0,
+	}
+	if b&^(modeMask|formMask|negBit) != 0 || h.mode > big.ToPositiveInf ||
+		h.form > formInf {
+		err = com.ErrWrongFormat
+		return
+	}
+	if h.form != formFinite {
+		return
+	}
+	exp, n1, err := varint.Int64.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	mag, n1, err = unmarshalMag(bs[n:], nil)
+	n += n1
+	if err != nil {
+		return
+	}
+	// The mantissa must be odd, fit the precision, and the resulting exponent
+	// must be in the big.Float range.
+	l := bitLen(mag)
+	if l == 0 || mag[len(mag)-1]&1 == 0 || uint(l) > h.prec ||
+		exp+int64(l) < big.MinExp || exp+int64(l) > big.MaxExp {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+func floatForm(v *big.Float) byte {
+	switch {
+	case v.IsInf():
+		return formInf
+	case v.Sign() == 0:
+		return formZero
+	default:
+		return formFinite
+	}
+}
+
+// floatMant returns an odd mantissa and an exponent, such that
+// |v| = mant * 2^exp.
+func floatMant(v *big.Float) (mant *big.Int, exp int64) {
+	var (
+		minPrec = v.MinPrec()
+		f       = new(big.Float)
+		e       = v.MantExp(f)
+	)
+	f.SetMantExp(f, int(minPrec))
+	mant, _ = f.Int(nil)
+	return mant.Abs(mant), int64(e) - int64(minPrec)
+}
+
+var zeroFloat = new(big.Float)
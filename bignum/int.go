@@ -0,0 +1,105 @@
+package bignum
+
+import (
+	"math/big"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	bnopts "github.com/mus-format/mus-go/options/bignum"
+)
+
+const (
+	positive byte = 0
+	negative byte = 1
+)
+
+// Int is a *big.Int serializer.
+var Int = intSer{}
+
+// NewValidIntSer returns a new valid *big.Int serializer.
+func NewValidIntSer(opts ...bnopts.SetOption) intSer {
+	o := bnopts.Options{}
+	bnopts.Apply(opts, &o)
+	return intSer{o.BitLenVl}
+}
+
+// intSer encodes a *big.Int value as 1 byte sign + magnitude (varint
+// length + big-endian bytes). A nil value is encoded as 0.
+type intSer struct {
+	bitLenVl com.Validator[int]
+}
+
+// Marshal fills bs with an encoded *big.Int value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s intSer) Marshal(v *big.Int, bs []byte) (n int) {
+	if v == nil {
+		v = zeroInt
+	}
+	if v.Sign() < 0 {
+		bs[0] = negative
+	} else {
+		bs[0] = positive
+	}
+	return 1 + marshalMag(v, bs[1:])
+}
+
+// Unmarshal parses an encoded *big.Int value from bs.
+//
+// In addition to the *big.Int value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrOverflow, com.ErrNegativeLength,
+// com.ErrWrongFormat or a bit length validation error.
+func (s intSer) Unmarshal(bs []byte) (v *big.Int, n int, err error) {
+	neg, mag, n, err := s.unmarshal(bs)
+	if err != nil {
+		return
+	}
+	v = new(big.Int).SetBytes(mag)
+	if neg {
+		v.Neg(v)
+	}
+	return
+}
+
+// Size returns the size of an encoded *big.Int value.
+func (s intSer) Size(v *big.Int) (size int) {
+	if v == nil {
+		v = zeroInt
+	}
+	return 1 + sizeMag(v)
+}
+
+// Skip skips an encoded *big.Int value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrOverflow, com.ErrNegativeLength,
+// com.ErrWrongFormat or a bit length validation error.
+func (s intSer) Skip(bs []byte) (n int, err error) {
+	_, _, n, err = s.unmarshal(bs)
+	return
+}
+
+func (s intSer) unmarshal(bs []byte) (neg bool, mag []byte, n int,
+	err error,
+) {
+	if len(bs) < 1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	if bs[0] != positive && bs[0] != negative {
+		err = com.ErrWrongFormat
+		return
+	}
+	neg = bs[0] == negative
+	mag, n, err = unmarshalMag(bs[1:], s.bitLenVl)
+	n++
+	if err != nil {
+		return
+	}
+	if neg && len(mag) == 0 {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+var zeroInt = new(big.Int)
@@ -0,0 +1,93 @@
+package bignum
+
+import (
+	"math/big"
+
+	com "github.com/mus-format/common-go"
+	bnopts "github.com/mus-format/mus-go/options/bignum"
+)
+
+// Rat is a *big.Rat serializer.
+var Rat = ratSer{}
+
+// NewValidRatSer returns a new valid *big.Rat serializer. The bit length
+// validator is applied to both the numerator and the denominator.
+func NewValidRatSer(opts ...bnopts.SetOption) ratSer {
+	o := bnopts.Options{}
+	bnopts.Apply(opts, &o)
+	return ratSer{intSer{o.BitLenVl}}
+}
+
+// ratSer encodes a *big.Rat value as numerator (like Int) + denominator
+// magnitude. A nil value is encoded as 0.
+type ratSer struct {
+	numSer intSer
+}
+
+// Marshal fills bs with an encoded *big.Rat value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s ratSer) Marshal(v *big.Rat, bs []byte) (n int) {
+	if v == nil {
+		v = zeroRat
+	}
+	n = s.numSer.Marshal(v.Num(), bs)
+	return n + marshalMag(v.Denom(), bs[n:])
+}
+
+// Unmarshal parses an encoded *big.Rat value from bs.
+//
+// In addition to the *big.Rat value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrOverflow, com.ErrNegativeLength,
+// com.ErrWrongFormat, if the denominator is zero, or a bit length validation
+// error.
+func (s ratSer) Unmarshal(bs []byte) (v *big.Rat, n int, err error) {
+	neg, num, denom, n, err := s.unmarshal(bs)
+	if err != nil {
+		return
+	}
+	a := new(big.Int).SetBytes(num)
+	if neg {
+		a.Neg(a)
+	}
+	v = new(big.Rat).SetFrac(a, new(big.Int).SetBytes(denom))
+	return
+}
+
+// Size returns the size of an encoded *big.Rat value.
+func (s ratSer) Size(v *big.Rat) (size int) {
+	if v == nil {
+		v = zeroRat
+	}
+	return s.numSer.Size(v.Num()) + sizeMag(v.Denom())
+}
+
+// Skip skips an encoded *big.Rat value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrOverflow, com.ErrNegativeLength,
+// com.ErrWrongFormat or a bit length validation error.
+func (s ratSer) Skip(bs []byte) (n int, err error) {
+	_, _, _, n, err = s.unmarshal(bs)
+	return
+}
+
+func (s ratSer) unmarshal(bs []byte) (neg bool, num, denom []byte, n int,
+	err error,
+) {
+	neg, num, n, err = s.numSer.unmarshal(bs)
+	if err != nil {
+		return
+	}
+	denom, n1, err := unmarshalMag(bs[n:], s.numSer.bitLenVl)
+	n += n1
+	if err != nil {
+		return
+	}
+	if len(denom) == 0 {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+var zeroRat = new(big.Rat)
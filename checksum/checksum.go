@@ -0,0 +1,13 @@
+// Package checksum provides a serializer that protects the encoded value with
+// a checksum.
+package checksum
+
+import (
+	"errors"
+
+	com "github.com/mus-format/common-go"
+)
+
+// ErrChecksumMismatch means that the checksum of the encoded value does not
+// match the stored one.
+var ErrChecksumMismatch = errors.New(com.ErrorPrefix + "checksum mismatch")
@@ -0,0 +1,27 @@
+package checksum
+
+import (
+	"testing"
+
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzChecksum_Ser(f *testing.F) {
+	ser := NewSer[string](ord.String)
+	f.Add("")
+	f.Add("abc")
+	f.Fuzz(func(t *testing.T, v string) {
+		test.Test([]string{v}, ser, t)
+		test.TestSkip([]string{v}, ser, t)
+	})
+}
+
+func FuzzChecksum_SerUnmarshal(f *testing.F) {
+	ser := NewSer[string](ord.String)
+	f.Add([]byte{4, 3, 'a', 'b', 'c', 1, 2, 3, 4})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
@@ -0,0 +1,170 @@
+package checksum
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"hash/fnv"
+	"strings"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	chkopts "github.com/mus-format/mus-go/options/checksum"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/sm"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+)
+
+func withCRC(bs []byte) []byte {
+	return binary.BigEndian.AppendUint32(bs,
+		crc32.Checksum(bs, crc32.MakeTable(crc32.Castagnoli)))
+}
+
+func TestChecksum_Ser(t *testing.T) {
+	t.Run("Marshal should append CRC-32C of the length + inner encoding",
+		func(t *testing.T) {
+			var (
+				ser    = NewSer[string](ord.String)
+				bs     = make([]byte, ser.Size("abc"))
+				n      = ser.Marshal("abc", bs)
+				wantBS = withCRC([]byte{4, 3, 'a', 'b', 'c'})
+			)
+			asserterror.Equal(t, n, len(wantBS))
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Checksummed serializer should work correctly", func(t *testing.T) {
+		ser := NewSer[[]int](ord.NewSliceSer(varint.Int))
+		test.Test([][]int{{}, {1, 2, 3}, {-100, 100000}}, ser, t)
+		test.TestSkip([][]int{{}, {1, 2, 3}}, ser, t)
+	})
+
+	t.Run("Checksummed serializer should work with a custom hash",
+		func(t *testing.T) {
+			ser := NewSer[string](ord.String, chkopts.WithHash(
+				func() hash.Hash { return fnv.New64a() }))
+			asserterror.Equal(t, ser.Size("abc"), 5+8)
+			test.Test([]string{"", "abc"}, ser, t)
+			test.TestSkip([]string{"abc"}, ser, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrChecksumMismatch if data is corrupted",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[2] ^= 0x04
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 5, Err: ErrChecksumMismatch}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 5, Err: ErrChecksumMismatch}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrChecksumMismatch if checksum is corrupted",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[len(bs)-1] ^= 0x01
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 5, Err: ErrChecksumMismatch}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 5, Err: ErrChecksumMismatch}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrChecksumMismatch if length is corrupted",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[0] ^= 0x07
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 4, Err: ErrChecksumMismatch}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 4, Err: ErrChecksumMismatch}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return mus.ErrTooSmallByteSlice if checksum is missing",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = []byte{4, 3, 'a', 'b', 'c', 1, 2}
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 1, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 1, Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrNegativeLength if length is negative",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = make([]byte, varint.PositiveInt.Size(-1))
+				n   = varint.PositiveInt.Marshal(-1, bs)
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: n, Err: com.ErrNegativeLength},
+				nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: n, Err: com.ErrNegativeLength}, nil, t)
+		})
+
+	t.Run("If inner serializer Unmarshal fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = withCRC([]byte{2, 3, 'a'})
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 2, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if the inner encoding is not consumed entirely",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String)
+				bs  = withCRC([]byte{5, 3, 'a', 'b', 'c', 'd'})
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{V: "abc", N: 6,
+					Err: com.ErrWrongFormat}, nil, t)
+		})
+
+	t.Run("Checksummed serializer should support stateful inner serializers",
+		func(t *testing.T) {
+			var (
+				strMap    = sm.NewStringMap()
+				revStrMap = sm.NewReverseStringMap()
+				ser       = sm.Wrap(strMap, revStrMap, NewSer[[]string](
+					ord.NewSliceSer[string](sm.NewStringSer(strMap, revStrMap))))
+			)
+			test.Test([][]string{{"a", "a", "b"}}, ser, t)
+			test.TestSkip([][]string{{"a", "a", "b"}}, ser, t)
+		})
+
+	t.Run("Marshal should shift the inner encoding if the length takes several bytes",
+		func(t *testing.T) {
+			ser := NewSer[string](ord.String)
+			test.Test([]string{strings.Repeat("a", 200)}, ser, t)
+		})
+
+	t.Run("Marshal should panic with mus.ErrTooSmallByteSlice if there is no space for checksum",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), mus.ErrTooSmallByteSlice)
+			}()
+			NewSer[string](ord.String).Marshal("abc", make([]byte, 5))
+		})
+}
@@ -0,0 +1,130 @@
+package checksum
+
+import (
+	"bytes"
+	"hash"
+	"hash/crc32"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	chkopts "github.com/mus-format/mus-go/options/checksum"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/varint"
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// NewSer returns a new checksummed serializer with the given inner
+// serializer. A value is encoded as varint.PositiveInt length + inner encoding
+// + checksum of both of them, CRC-32C (Castagnoli) by default. To use another
+// hash function, use chkopts.WithHash.
+//
+// The checksum is verified before the inner serializer is called, so
+// corrupted data is never parsed by it.
+func NewSer[T any](ser mus.Serializer[T],
+	opts ...chkopts.SetOption,
+) checksumSer[T] {
+	o := chkopts.Options{}
+	chkopts.Apply(opts, &o)
+
+	newHash := o.NewHash
+	if newHash == nil {
+		newHash = func() hash.Hash { return crc32.New(castagnoli) }
+	}
+	return checksumSer[T]{ser, newHash, newHash().Size()}
+}
+
+// checksumSer implements the mus.Serializer interface and appends a checksum
+// to the encoded inner value.
+type checksumSer[T any] struct {
+	ser     mus.Serializer[T]
+	newHash func() hash.Hash
+	sumSize int
+}
+
+// Marshal fills bs with an encoded value and its checksum.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s checksumSer[T]) Marshal(v T, bs []byte) (n int) {
+	n = ord.MarshalLengthPrefixed(func(bs []byte) int {
+		return s.ser.Marshal(v, bs)
+	}, bs)
+	if len(bs[n:]) < s.sumSize {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	n += copy(bs[n:], s.sum(bs[:n]))
+	return
+}
+
+// Unmarshal verifies the checksum and parses an encoded value from bs.
+//
+// In addition to the value and the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, ErrChecksumMismatch,
+// com.ErrWrongFormat, if the inner encoding is not consumed entirely, a length
+// unmarshalling error, or an inner serializer unmarshalling error.
+func (s checksumSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	lenSize, n, err := s.verify(bs)
+	if err != nil {
+		return
+	}
+	v, n1, err := s.ser.Unmarshal(bs[lenSize:n])
+	if err != nil {
+		n = lenSize + n1
+		return
+	}
+	if lenSize+n1 != n {
+		err = com.ErrWrongFormat
+		return
+	}
+	n += s.sumSize
+	return
+}
+
+// Size returns the size of an encoded value with its checksum.
+func (s checksumSer[T]) Size(v T) (size int) {
+	size = s.ser.Size(v)
+	return varint.PositiveInt.Size(size) + size + s.sumSize
+}
+
+// Skip verifies the checksum and skips an encoded value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, ErrChecksumMismatch, or a
+// length unmarshalling error.
+func (s checksumSer[T]) Skip(bs []byte) (n int, err error) {
+	_, n, err = s.verify(bs)
+	if err != nil {
+		return
+	}
+	n += s.sumSize
+	return
+}
+
+// verify returns the size of the length and the length of the checksummed
+// data.
+func (s checksumSer[T]) verify(bs []byte) (lenSize, n int, err error) {
+	length, lenSize, err := varint.PositiveInt.Unmarshal(bs)
+	n = lenSize
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > len(bs[n:])-s.sumSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	n += length
+	if !bytes.Equal(s.sum(bs[:n]), bs[n:n+s.sumSize]) {
+		err = ErrChecksumMismatch
+	}
+	return
+}
+
+func (s checksumSer[T]) sum(bs []byte) []byte {
+	h := s.newHash()
+	h.Write(bs)
+	return h.Sum(nil)
+}
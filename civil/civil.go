@@ -0,0 +1,117 @@
+// Package civil provides serializers for civil (time zone independent) dates
+// and times of day, as well as for time.Month and time.Weekday values.
+package civil
+
+import (
+	"errors"
+	"time"
+
+	com "github.com/mus-format/common-go"
+)
+
+// ErrOutOfRange means that a value is not a valid date, time of day, month or
+// weekday.
+var ErrOutOfRange = errors.New(com.ErrorPrefix + "value out of range")
+
+// Date represents a calendar date without a time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the Date in which t occurs in t's location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// IsValid reports whether the date exists in the proleptic Gregorian calendar.
+func (d Date) IsValid() bool {
+	t := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+	return DateOf(t) == d
+}
+
+// In returns the time corresponding to the midnight of the date in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// TimeOfDay represents a time of day with nanosecond precision, without a date
+// and a time zone.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the TimeOfDay at which t occurs in t's location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+	}
+}
+
+// IsValid reports whether all fields of the time of day are in range.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < 1e9
+}
+
+func (t TimeOfDay) nanos() uint64 {
+	return uint64(time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond))
+}
+
+func timeOfDayOfNanos(ns uint64) TimeOfDay {
+	return TimeOfDay{
+		Hour:       int(ns / uint64(time.Hour)),
+		Minute:     int(ns / uint64(time.Minute) % 60),
+		Second:     int(ns / uint64(time.Second) % 60),
+		Nanosecond: int(ns % uint64(time.Second)),
+	}
+}
+
+// ValidateDate returns ErrOutOfRange if the date is not valid. It can be
+// used as a com.ValidatorFn.
+func ValidateDate(d Date) (err error) {
+	if !d.IsValid() {
+		err = ErrOutOfRange
+	}
+	return
+}
+
+// ValidateTimeOfDay returns ErrOutOfRange if the time of day is not valid. It
+// can be used as a com.ValidatorFn.
+func ValidateTimeOfDay(t TimeOfDay) (err error) {
+	if !t.IsValid() {
+		err = ErrOutOfRange
+	}
+	return
+}
+
+// ValidateMonth returns ErrOutOfRange if the month is not in the
+// [time.January, time.December] range. It can be used as a com.ValidatorFn.
+func ValidateMonth(m time.Month) (err error) {
+	if m < time.January || m > time.December {
+		err = ErrOutOfRange
+	}
+	return
+}
+
+// ValidateWeekday returns ErrOutOfRange if the weekday is not in the
+// [time.Sunday, time.Saturday] range. It can be used as a com.ValidatorFn.
+func ValidateWeekday(d time.Weekday) (err error) {
+	if d < time.Sunday || d > time.Saturday {
+		err = ErrOutOfRange
+	}
+	return
+}
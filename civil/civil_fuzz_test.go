@@ -0,0 +1,38 @@
+package civil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzCivil_Date(f *testing.F) {
+	f.Fuzz(func(t *testing.T, year int32, month, day uint8) {
+		v := Date{Year: int(year), Month: time.Month(month%12 + 1),
+			Day: int(day%28 + 1)}
+		test.Test([]Date{v}, DateSer, t)
+		test.TestSkip([]Date{v}, DateSer, t)
+	})
+}
+
+func FuzzCivil_TimeOfDay(f *testing.F) {
+	f.Fuzz(func(t *testing.T, ns uint64) {
+		v := timeOfDayOfNanos(ns % uint64(24*time.Hour))
+		test.Test([]TimeOfDay{v}, TimeOfDaySer, t)
+		test.TestSkip([]TimeOfDay{v}, TimeOfDaySer, t)
+	})
+}
+
+func FuzzCivil_Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		DateSer.Unmarshal(bs)
+		DateSer.Skip(bs)
+		TimeOfDaySer.Unmarshal(bs)
+		TimeOfDaySer.Skip(bs)
+		MonthSer.Unmarshal(bs)
+		MonthSer.Skip(bs)
+		WeekdaySer.Unmarshal(bs)
+		WeekdaySer.Skip(bs)
+	})
+}
@@ -0,0 +1,210 @@
+package civil
+
+import (
+	"testing"
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/test"
+	asserterror "github.com/ymz-ncnk/assert/error"
+)
+
+func TestCivil_Date(t *testing.T) {
+	t.Run("Date serializer should succeed", func(t *testing.T) {
+		cases := []Date{
+			{Year: 2024, Month: time.February, Day: 29},
+			{Year: 1, Month: time.January, Day: 1},
+			{Year: -4713, Month: time.November, Day: 24},
+			{Year: 99999, Month: time.December, Day: 31},
+		}
+		test.Test(cases, DateSer, t)
+		test.TestSkip(cases, DateSer, t)
+	})
+
+	t.Run("Date should be encoded as year + month + day", func(t *testing.T) {
+		var (
+			v      = Date{Year: 2024, Month: time.March, Day: 15}
+			wantBS = []byte{0xd0, 0x1f, 3, 15}
+			bs     = make([]byte, DateSer.Size(v))
+		)
+		DateSer.Marshal(v, bs)
+		asserterror.EqualDeep(t, bs, wantBS)
+	})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the date is not valid",
+		func(t *testing.T) {
+			for _, bs := range [][]byte{
+				{0xd0, 0x1f, 0, 1},
+				{0xd0, 0x1f, 13, 1},
+				{0xd0, 0x1f, 1, 0},
+				{0xd0, 0x1f, 4, 31},
+				{0xce, 0x1f, 2, 29},
+			} {
+				var (
+					want = test.UnmarshalResult[Date]{
+						N:   4,
+						Err: com.ErrWrongFormat,
+					}
+				)
+				test.TestUnmarshalOnly(bs, DateSer, want, nil, t)
+				n, err := DateSer.Skip(bs)
+				asserterror.Equal(t, n, 4)
+				asserterror.EqualError(t, err, com.ErrWrongFormat)
+			}
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no month or day",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[Date]{
+					N:   2,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = []byte{0xd0, 0x1f, 3}
+			)
+			test.TestUnmarshalOnly(bs, DateSer, want, nil, t)
+		})
+
+	t.Run("DateOf should return the date of the time", func(t *testing.T) {
+		var (
+			tm   = time.Date(2024, 3, 15, 23, 30, 0, 0, time.UTC)
+			want = Date{Year: 2024, Month: time.March, Day: 15}
+		)
+		asserterror.Equal(t, DateOf(tm), want)
+		asserterror.Equal(t, want.In(time.UTC), time.Date(2024, 3, 15, 0, 0, 0,
+			0, time.UTC))
+	})
+
+	t.Run("ValidateDate should return ErrOutOfRange if the date is not valid",
+		func(t *testing.T) {
+			asserterror.EqualError(t, ValidateDate(Date{2023, time.February, 29}),
+				ErrOutOfRange)
+			asserterror.EqualError(t, ValidateDate(Date{2024, time.February, 29}),
+				nil)
+		})
+}
+
+func TestCivil_TimeOfDay(t *testing.T) {
+	t.Run("TimeOfDay serializer should succeed", func(t *testing.T) {
+		cases := []TimeOfDay{
+			{},
+			{Hour: 12, Minute: 30, Second: 15, Nanosecond: 123456789},
+			{Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999},
+		}
+		test.Test(cases, TimeOfDaySer, t)
+		test.TestSkip(cases, TimeOfDaySer, t)
+	})
+
+	t.Run("TimeOfDay should be encoded as nanoseconds since midnight",
+		func(t *testing.T) {
+			var (
+				v      = TimeOfDay{Nanosecond: 300}
+				wantBS = []byte{0xac, 0x02}
+				bs     = make([]byte, TimeOfDaySer.Size(v))
+			)
+			TimeOfDaySer.Marshal(v, bs)
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the value is not less than 24 hours",
+		func(t *testing.T) {
+			var (
+				v    = TimeOfDay{Hour: 24}
+				bs   = make([]byte, TimeOfDaySer.Size(v))
+				want = test.UnmarshalResult[TimeOfDay]{
+					N:   len(bs),
+					Err: com.ErrWrongFormat,
+				}
+			)
+			TimeOfDaySer.Marshal(v, bs)
+			test.TestUnmarshalOnly(bs, TimeOfDaySer, want, nil, t)
+		})
+
+	t.Run("TimeOfDayOf should return the time of day of the time",
+		func(t *testing.T) {
+			var (
+				tm   = time.Date(2024, 3, 15, 23, 30, 1, 2, time.UTC)
+				want = TimeOfDay{Hour: 23, Minute: 30, Second: 1, Nanosecond: 2}
+			)
+			asserterror.Equal(t, TimeOfDayOf(tm), want)
+		})
+
+	t.Run("ValidateTimeOfDay should return ErrOutOfRange if the time of day is not valid",
+		func(t *testing.T) {
+			for _, v := range []TimeOfDay{
+				{Hour: 24},
+				{Minute: 60},
+				{Second: -1},
+				{Nanosecond: 1e9},
+			} {
+				asserterror.EqualError(t, ValidateTimeOfDay(v), ErrOutOfRange)
+			}
+			asserterror.EqualError(t, ValidateTimeOfDay(TimeOfDay{Hour: 23}), nil)
+		})
+}
+
+func TestCivil_Month(t *testing.T) {
+	t.Run("Month serializer should succeed", func(t *testing.T) {
+		cases := []time.Month{time.January, time.June, time.December}
+		test.Test(cases, MonthSer, t)
+		test.TestSkip(cases, MonthSer, t)
+	})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the month is out of range",
+		func(t *testing.T) {
+			for _, bs := range [][]byte{{0}, {13}} {
+				var (
+					want = test.UnmarshalResult[time.Month]{
+						Err: com.ErrWrongFormat,
+					}
+				)
+				test.TestUnmarshalOnly(bs, MonthSer, want, nil, t)
+			}
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if bs is empty",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[time.Month]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly([]byte{}, MonthSer, want, nil, t)
+		})
+
+	t.Run("ValidateMonth should return ErrOutOfRange if the month is out of range",
+		func(t *testing.T) {
+			asserterror.EqualError(t, ValidateMonth(0), ErrOutOfRange)
+			asserterror.EqualError(t, ValidateMonth(13), ErrOutOfRange)
+			asserterror.EqualError(t, ValidateMonth(time.May), nil)
+		})
+}
+
+func TestCivil_Weekday(t *testing.T) {
+	t.Run("Weekday serializer should succeed", func(t *testing.T) {
+		cases := []time.Weekday{time.Sunday, time.Wednesday, time.Saturday}
+		test.Test(cases, WeekdaySer, t)
+		test.TestSkip(cases, WeekdaySer, t)
+	})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the weekday is out of range",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[time.Weekday]{
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly([]byte{7}, WeekdaySer, want, nil, t)
+			n, err := WeekdaySer.Skip([]byte{7})
+			asserterror.Equal(t, n, 0)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+		})
+
+	t.Run("ValidateWeekday should return ErrOutOfRange if the weekday is out of range",
+		func(t *testing.T) {
+			asserterror.EqualError(t, ValidateWeekday(-1), ErrOutOfRange)
+			asserterror.EqualError(t, ValidateWeekday(7), ErrOutOfRange)
+			asserterror.EqualError(t, ValidateWeekday(time.Monday), nil)
+		})
+}
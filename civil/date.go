@@ -0,0 +1,63 @@
+package civil
+
+import (
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// DateSer is a Date serializer that encodes a value as ZigZag Varint year +
+// 1 byte month + 1 byte day.
+var DateSer = dateSer{}
+
+type dateSer struct{}
+
+// Marshal fills bs with an encoded Date value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s dateSer) Marshal(v Date, bs []byte) (n int) {
+	n = varint.Int.Marshal(v.Year, bs)
+	bs[n] = byte(v.Month)
+	bs[n+1] = byte(v.Day)
+	return n + 2
+}
+
+// Unmarshal parses an encoded Date value from bs.
+//
+// In addition to the Date value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrOverflow or com.ErrWrongFormat, if
+// the date is not valid.
+func (s dateSer) Unmarshal(bs []byte) (v Date, n int, err error) {
+	year, n, err := varint.Int.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if len(bs) < n+2 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	d := Date{Year: year, Month: time.Month(bs[n]), Day: int(bs[n+1])}
+	n += 2
+	if !d.IsValid() {
+		err = com.ErrWrongFormat
+		return
+	}
+	v = d
+	return
+}
+
+// Size returns the size of an encoded Date value.
+func (s dateSer) Size(v Date) (size int) {
+	return varint.Int.Size(v.Year) + 2
+}
+
+// Skip skips an encoded Date value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrOverflow or com.ErrWrongFormat.
+func (s dateSer) Skip(bs []byte) (n int, err error) {
+	_, n, err = s.Unmarshal(bs)
+	return
+}
@@ -0,0 +1,101 @@
+package civil
+
+import (
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+var (
+	// MonthSer is a time.Month serializer that encodes a value as 1 byte.
+	MonthSer = monthSer{}
+	// WeekdaySer is a time.Weekday serializer that encodes a value as 1 byte.
+	WeekdaySer = weekdaySer{}
+)
+
+// month -----------------------------------------------------------------------
+
+type monthSer struct{}
+
+// Marshal fills bs with an encoded time.Month value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s monthSer) Marshal(v time.Month, bs []byte) (n int) {
+	bs[0] = byte(v)
+	return 1
+}
+
+// Unmarshal parses an encoded time.Month value from bs.
+//
+// In addition to the time.Month value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice or com.ErrWrongFormat, if the month is
+// not in the [time.January, time.December] range.
+func (s monthSer) Unmarshal(bs []byte) (v time.Month, n int, err error) {
+	if _, err = s.Skip(bs); err != nil {
+		return
+	}
+	return time.Month(bs[0]), 1, nil
+}
+
+// Size returns the size of an encoded time.Month value.
+func (s monthSer) Size(_ time.Month) (size int) {
+	return 1
+}
+
+// Skip skips an encoded time.Month value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrWrongFormat.
+func (s monthSer) Skip(bs []byte) (n int, err error) {
+	if len(bs) < 1 {
+		return 0, mus.ErrTooSmallByteSlice
+	}
+	if ValidateMonth(time.Month(bs[0])) != nil {
+		return 0, com.ErrWrongFormat
+	}
+	return 1, nil
+}
+
+// weekday ---------------------------------------------------------------------
+
+type weekdaySer struct{}
+
+// Marshal fills bs with an encoded time.Weekday value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s weekdaySer) Marshal(v time.Weekday, bs []byte) (n int) {
+	bs[0] = byte(v)
+	return 1
+}
+
+// Unmarshal parses an encoded time.Weekday value from bs.
+//
+// In addition to the time.Weekday value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice or com.ErrWrongFormat, if the weekday
+// is not in the [time.Sunday, time.Saturday] range.
+func (s weekdaySer) Unmarshal(bs []byte) (v time.Weekday, n int, err error) {
+	if _, err = s.Skip(bs); err != nil {
+		return
+	}
+	return time.Weekday(bs[0]), 1, nil
+}
+
+// Size returns the size of an encoded time.Weekday value.
+func (s weekdaySer) Size(_ time.Weekday) (size int) {
+	return 1
+}
+
+// Skip skips an encoded time.Weekday value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrWrongFormat.
+func (s weekdaySer) Skip(bs []byte) (n int, err error) {
+	if len(bs) < 1 {
+		return 0, mus.ErrTooSmallByteSlice
+	}
+	if ValidateWeekday(time.Weekday(bs[0])) != nil {
+		return 0, com.ErrWrongFormat
+	}
+	return 1, nil
+}
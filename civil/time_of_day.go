@@ -0,0 +1,53 @@
+package civil
+
+import (
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// TimeOfDaySer is a TimeOfDay serializer that encodes a value as Varint
+// nanoseconds since midnight.
+var TimeOfDaySer = timeOfDaySer{}
+
+type timeOfDaySer struct{}
+
+// Marshal fills bs with an encoded TimeOfDay value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s timeOfDaySer) Marshal(v TimeOfDay, bs []byte) (n int) {
+	return varint.Uint64.Marshal(v.nanos(), bs)
+}
+
+// Unmarshal parses an encoded TimeOfDay value from bs.
+//
+// In addition to the TimeOfDay value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrOverflow or com.ErrWrongFormat, if
+// the value is not less than 24 hours.
+func (s timeOfDaySer) Unmarshal(bs []byte) (v TimeOfDay, n int, err error) {
+	ns, n, err := varint.Uint64.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if ns >= uint64(24*time.Hour) {
+		err = com.ErrWrongFormat
+		return
+	}
+	v = timeOfDayOfNanos(ns)
+	return
+}
+
+// Size returns the size of an encoded TimeOfDay value.
+func (s timeOfDaySer) Size(v TimeOfDay) (size int) {
+	return varint.Uint64.Size(v.nanos())
+}
+
+// Skip skips an encoded TimeOfDay value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrOverflow or com.ErrWrongFormat.
+func (s timeOfDaySer) Skip(bs []byte) (n int, err error) {
+	_, n, err = s.Unmarshal(bs)
+	return
+}
@@ -0,0 +1,17 @@
+// Package compress provides a serializer that stores the encoded value as a
+// compressed block.
+package compress
+
+// DefaultMaxLen is the default maximum decompressed length, 64 MiB.
+const DefaultMaxLen = 64 << 20
+
+// Codec compresses and decompresses blocks of data.
+type Codec interface {
+	// Compress returns the compressed form of src.
+	Compress(src []byte) ([]byte, error)
+
+	// Decompress decompresses src into dst, which has exactly the length of
+	// the decompressed data. It should return an error if src does not
+	// decompress to exactly len(dst) bytes.
+	Decompress(dst, src []byte) error
+}
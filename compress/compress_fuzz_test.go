@@ -0,0 +1,40 @@
+package compress
+
+import (
+	"errors"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	cmpopts "github.com/mus-format/mus-go/options/compress"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+)
+
+const maxLen = 1000
+
+func FuzzCompress_CompressedSer(f *testing.F) {
+	ser := NewCompressedSer[string](ord.String, Flate)
+	f.Add("")
+	f.Add("hello hello hello")
+	f.Fuzz(func(t *testing.T, v string) {
+		test.Test([]string{v}, ser, t)
+		test.TestSkip([]string{v}, ser, t)
+	})
+}
+
+func FuzzCompress_CompressedSerUnmarshal(f *testing.F) {
+	// We use Valid serializer to avoid OOM during fuzzing.
+	ser := NewValidCompressedSer[string](ord.String, Flate,
+		cmpopts.WithLenValidator(com.ValidatorFn[int](func(v int) error {
+			if v > maxLen {
+				return errors.New("too large length")
+			}
+			return nil
+		})),
+	)
+	f.Add([]byte{2, 4, 1})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
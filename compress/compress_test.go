@@ -0,0 +1,230 @@
+package compress
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	cmpopts "github.com/mus-format/mus-go/options/compress"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/sm"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+)
+
+func TestCompress_CompressedSer(t *testing.T) {
+	t.Run("Compressed serializer should work correctly", func(t *testing.T) {
+		ser := NewCompressedSer[[]string](ord.NewSliceSer(ord.String), Flate)
+		test.Test([][]string{
+			{},
+			{""},
+			{"host-1", "host-2", "host-1"},
+			{strings.Repeat("event payload ", 1000)},
+		}, ser, t)
+		test.TestSkip([][]string{
+			{"host-1", "host-2", "host-1"},
+			{strings.Repeat("event payload ", 1000)},
+		}, ser, t)
+	})
+
+	t.Run("Repetitive value should be compressed", func(t *testing.T) {
+		var (
+			ser  = NewCompressedSer[string](ord.String, Flate)
+			v    = strings.Repeat("a", 10000)
+			size = ser.Size(v)
+		)
+		asserterror.Equal(t, size < ord.String.Size(v)/10, true,
+			fmt.Sprintf("value is not compressed, size '%v'", size))
+	})
+
+	t.Run("Marshal should use the compressed form cached by Size",
+		func(t *testing.T) {
+			var (
+				codec = &countingCodec{}
+				ser   = NewCompressedSer[string](ord.String, codec)
+				size  = ser.Size("abc")
+				bs    = make([]byte, size)
+			)
+			ser.Marshal("abc", bs)
+			asserterror.Equal(t, codec.count, 1,
+				fmt.Sprintf("unexpected compress calls, want '1' actual '%v'",
+					codec.count))
+
+			ser.Marshal("abc", bs)
+			asserterror.Equal(t, codec.count, 2,
+				fmt.Sprintf("unexpected compress calls, want '2' actual '%v'",
+					codec.count))
+		})
+
+	t.Run("Compressed serializer should support wrapped stateful inner serializers",
+		func(t *testing.T) {
+			var (
+				strMap    = sm.NewStringMap()
+				revStrMap = sm.NewReverseStringMap()
+				ser       = NewCompressedSer[[]string](sm.Wrap(strMap, revStrMap,
+					ord.NewSliceSer[string](sm.NewStringSer(strMap, revStrMap))),
+					Flate)
+				v = []string{strings.Repeat("a", 5000), "b",
+					strings.Repeat("a", 5000)}
+			)
+			test.Test([][]string{v}, ser, t)
+		})
+
+	t.Run("Marshal should panic if the codec fails", func(t *testing.T) {
+		wantErr := errors.New("compress error")
+		defer func() {
+			r := recover()
+			asserterror.Equal[any](t, r, wantErr)
+		}()
+		ser := NewCompressedSer[string](ord.String,
+			&countingCodec{err: wantErr})
+		ser.Marshal("abc", make([]byte, 10))
+	})
+
+	t.Run("Unmarshal should return com.ErrNegativeLength if the decompressed length is negative",
+		func(t *testing.T) {
+			var (
+				n, bs = negativeLengthBs()
+				want  = test.UnmarshalResult[string]{
+					N:   n,
+					Err: com.ErrNegativeLength,
+				}
+				ser = NewCompressedSer[string](ord.String, Flate)
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return com.ErrTooLargeLength if the decompressed length exceeds the maximum",
+		func(t *testing.T) {
+			var (
+				bs  = make([]byte, varint.PositiveInt.Size(1<<40)+2)
+				n   = varint.PositiveInt.Marshal(1<<40, bs)
+				ser = NewCompressedSer[string](ord.String, Flate)
+			)
+			bs[n] = 1
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: n, Err: com.ErrTooLargeLength}, nil,
+				t)
+
+			ser = NewCompressedSer[string](ord.String, Flate, cmpopts.WithMaxLen(3))
+			bs = make([]byte, ser.Size("abc"))
+			ser.Marshal("abc", bs)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 1, Err: com.ErrTooLargeLength}, nil,
+				t)
+		})
+
+	t.Run("Unmarshal should return mus.ErrTooSmallByteSlice if bs is shorter than the compressed length",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[string]{
+					N:   2,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs  = []byte{2, 4, 1}
+				ser = NewCompressedSer[string](ord.String, Flate)
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if the block does not decompress to the declared length",
+		func(t *testing.T) {
+			var (
+				ser = NewCompressedSer[string](ord.String, Flate)
+				bs  = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[0] = 10
+			_, _, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+
+			bs[0] = 2
+			_, _, err = ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if the inner serializer does not consume the whole block",
+		func(t *testing.T) {
+			var (
+				ser = NewCompressedSer[string](ord.String, Flate)
+				bs  = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			_, _, err := NewCompressedSer[byte](varint.Byte, Flate).Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+		})
+
+	t.Run("Skip should return mus.ErrTooSmallByteSlice if bs is shorter than the compressed length",
+		func(t *testing.T) {
+			var (
+				want = test.SkipResult{N: 2, Err: mus.ErrTooSmallByteSlice}
+				bs   = []byte{2, 4, 1}
+				ser  = NewCompressedSer[string](ord.String, Flate)
+			)
+			test.TestSkipOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Valid compressed serializer should validate the decompressed length",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("too large length")
+				ser     = NewValidCompressedSer[string](ord.String, Flate,
+					cmpopts.WithLenValidator(com.ValidatorFn[int](
+						func(l int) (err error) {
+							if l > 4 {
+								err = wantErr
+							}
+							return
+						})))
+				bs = make([]byte, ser.Size("abcd"))
+			)
+			// ord.String encodes "abcd" into 5 bytes.
+			ser.Marshal("abcd", bs)
+			_, _, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, wantErr)
+
+			test.Test([]string{"abc"}, mus.Serializer[string](ser), t)
+		})
+}
+
+func TestCompress_Flate(t *testing.T) {
+	t.Run("NewFlateCodec should panic if the level is invalid",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal(t, recover() != nil, true, "no panic")
+			}()
+			NewFlateCodec(100)
+		})
+}
+
+type countingCodec struct {
+	count int
+	err   error
+}
+
+func (c *countingCodec) Compress(src []byte) ([]byte, error) {
+	c.count++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return append([]byte(nil), src...), nil
+}
+
+func (c *countingCodec) Decompress(dst, src []byte) error {
+	if len(dst) != len(src) {
+		return com.ErrWrongFormat
+	}
+	copy(dst, src)
+	return nil
+}
+
+func negativeLengthBs() (n int, bs []byte) {
+	n = varint.PositiveInt.Size(-1)
+	bs = make([]byte, n)
+	varint.PositiveInt.Marshal(-1, bs)
+	return
+}
@@ -0,0 +1,75 @@
+package compress
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"sync"
+
+	com "github.com/mus-format/common-go"
+)
+
+// Flate is a Codec that uses compress/flate with the default compression
+// level.
+var Flate = NewFlateCodec(flate.DefaultCompression)
+
+// NewFlateCodec returns a new compress/flate Codec with the given compression
+// level.
+//
+// Panics if the level is not valid.
+func NewFlateCodec(level int) *FlateCodec {
+	if _, err := flate.NewWriter(io.Discard, level); err != nil {
+		panic(err)
+	}
+	c := &FlateCodec{}
+	c.writers.New = func() any {
+		w, _ := flate.NewWriter(nil, level)
+		return w
+	}
+	return c
+}
+
+// FlateCodec implements the Codec interface using compress/flate.
+type FlateCodec struct {
+	writers sync.Pool
+}
+
+// Compress returns the compressed form of src.
+func (c *FlateCodec) Compress(src []byte) (bs []byte, err error) {
+	var (
+		buf bytes.Buffer
+		w   = c.writers.Get().(*flate.Writer)
+	)
+	defer c.writers.Put(w)
+	w.Reset(&buf)
+	if _, err = w.Write(src); err != nil {
+		return
+	}
+	if err = w.Close(); err != nil {
+		return
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress decompresses src into dst.
+//
+// Returns com.ErrWrongFormat if src does not decompress to exactly len(dst)
+// bytes, or a decompression error.
+func (c *FlateCodec) Decompress(dst, src []byte) (err error) {
+	r := flate.NewReader(bytes.NewReader(src))
+	defer r.Close()
+	if _, err = io.ReadFull(r, dst); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = com.ErrWrongFormat
+		}
+		return
+	}
+	var b [1]byte
+	switch _, err = r.Read(b[:]); err {
+	case io.EOF:
+		err = nil
+	case nil, io.ErrUnexpectedEOF:
+		err = com.ErrWrongFormat
+	}
+	return
+}
@@ -0,0 +1,238 @@
+package compress
+
+import (
+	"bytes"
+	"sync"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	cmpopts "github.com/mus-format/mus-go/options/compress"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// cacheSize defines how many compressed values computed by Size are kept for
+// the following Marshal calls.
+const cacheSize = 8
+
+// NewCompressedSer returns a new compressed serializer with the given inner
+// serializer and codec. A value is encoded as varint.PositiveInt decompressed
+// length + varint.PositiveInt compressed length + compressed block.
+//
+// Size compresses the value to know its encoded size and caches the result,
+// so that the following Marshal of the same value does not compress it again.
+//
+// Both Size and Marshal marshal the inner value into a buffer of the inner
+// Size, so a stateful inner serializer, such as sm or pm one, must be wrapped
+// with sm.Wrap or pm.Wrap inside the compressed serializer.
+//
+// The decompressed length is limited by DefaultMaxLen, to change it use
+// cmpopts.WithMaxLen. Use NewValidCompressedSer with a length validator for
+// additional checks.
+func NewCompressedSer[T any](ser mus.Serializer[T], codec Codec,
+	opts ...cmpopts.SetOption,
+) *compressedSer[T] {
+	o := cmpopts.Options{MaxLen: DefaultMaxLen}
+	cmpopts.Apply(opts, &o)
+
+	return &compressedSer[T]{ser: ser, codec: codec, maxLen: o.MaxLen}
+}
+
+// NewValidCompressedSer returns a new valid compressed serializer. The length
+// validator is applied to the decompressed length before any allocation.
+func NewValidCompressedSer[T any](ser mus.Serializer[T], codec Codec,
+	opts ...cmpopts.SetOption,
+) validCompressedSer[T] {
+	o := cmpopts.Options{}
+	cmpopts.Apply(opts, &o)
+
+	return validCompressedSer[T]{
+		compressedSer: NewCompressedSer(ser, codec, opts...),
+		lenVl:         o.LenVl,
+	}
+}
+
+type compressedSer[T any] struct {
+	ser    mus.Serializer[T]
+	codec  Codec
+	maxLen int
+	mu     sync.Mutex
+	cache  []cacheEntry
+}
+
+type cacheEntry struct {
+	raw        []byte
+	compressed []byte
+}
+
+// Marshal fills bs with an encoded compressed value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs, or
+// if the codec fails to compress the value.
+func (s *compressedSer[T]) Marshal(v T, bs []byte) (n int) {
+	raw := s.marshalRaw(v)
+	compressed, pst := s.take(raw)
+	if !pst {
+		compressed = s.compress(raw)
+	}
+	n = varint.PositiveInt.Marshal(len(raw), bs)
+	n += varint.PositiveInt.Marshal(len(compressed), bs[n:])
+	if len(bs[n:]) < len(compressed) {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	n += copy(bs[n:], compressed)
+	return
+}
+
+// Unmarshal parses an encoded compressed value from bs.
+//
+// In addition to the value and the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrTooLargeLength, if
+// the decompressed length exceeds the maximum, com.ErrWrongFormat, if the
+// decompressed block is not consumed entirely by the inner serializer, a
+// length unmarshalling error, a decompression error, or an inner serializer
+// unmarshalling error.
+func (s *compressedSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	return s.unmarshal(bs, nil)
+}
+
+// Size returns the size of an encoded compressed value.
+//
+// It will panic if the codec fails to compress the value.
+func (s *compressedSer[T]) Size(v T) (size int) {
+	var (
+		raw        = s.marshalRaw(v)
+		compressed = s.compress(raw)
+	)
+	s.put(raw, compressed)
+	return varint.PositiveInt.Size(len(raw)) +
+		varint.PositiveInt.Size(len(compressed)) + len(compressed)
+}
+
+// Skip skips an encoded compressed value without decompressing it.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a length unmarshalling
+// error.
+func (s *compressedSer[T]) Skip(bs []byte) (n int, err error) {
+	_, n, err = unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	l, n1, err := unmarshalLength(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if len(bs[n:]) < l {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	n += l
+	return
+}
+
+func (s *compressedSer[T]) unmarshal(bs []byte, lenVl com.Validator[int]) (
+	v T, n int, err error,
+) {
+	rawLen, n, err := unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	if rawLen > s.maxLen {
+		err = com.ErrTooLargeLength
+		return
+	}
+	if lenVl != nil {
+		if err = lenVl.Validate(rawLen); err != nil {
+			return
+		}
+	}
+	l, n1, err := unmarshalLength(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if len(bs[n:]) < l {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	raw := make([]byte, rawLen)
+	if err = s.codec.Decompress(raw, bs[n:n+l]); err != nil {
+		return
+	}
+	n += l
+	v, n1, err = s.ser.Unmarshal(raw)
+	if err != nil {
+		return
+	}
+	if n1 != rawLen {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+func (s *compressedSer[T]) marshalRaw(v T) (raw []byte) {
+	raw = make([]byte, s.ser.Size(v))
+	n := s.ser.Marshal(v, raw)
+	return raw[:n]
+}
+
+func (s *compressedSer[T]) compress(raw []byte) (compressed []byte) {
+	compressed, err := s.codec.Compress(raw)
+	if err != nil {
+		panic(err)
+	}
+	return
+}
+
+func (s *compressedSer[T]) put(raw, compressed []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.cache) == cacheSize {
+		s.cache = s.cache[1:]
+	}
+	s.cache = append(s.cache, cacheEntry{raw, compressed})
+}
+
+func (s *compressedSer[T]) take(raw []byte) (compressed []byte, pst bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.cache) - 1; i >= 0; i-- {
+		if bytes.Equal(s.cache[i].raw, raw) {
+			compressed = s.cache[i].compressed
+			s.cache = append(s.cache[:i], s.cache[i+1:]...)
+			return compressed, true
+		}
+	}
+	return
+}
+
+// valid -----------------------------------------------------------------------
+
+type validCompressedSer[T any] struct {
+	*compressedSer[T]
+	lenVl com.Validator[int]
+}
+
+// Unmarshal parses an encoded compressed value from bs.
+//
+// In addition to the value and the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrTooLargeLength,
+// com.ErrWrongFormat, a length unmarshalling error, a length validation error,
+// a decompression error, or an inner serializer unmarshalling error.
+func (s validCompressedSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	return s.unmarshal(bs, s.lenVl)
+}
+
+// -----------------------------------------------------------------------------
+
+func unmarshalLength(bs []byte) (length, n int, err error) {
+	length, n, err = varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+	}
+	return
+}
@@ -0,0 +1,205 @@
+package decimal
+
+import (
+	"math/big"
+	"math/bits"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	decopts "github.com/mus-format/mus-go/options/decimal"
+)
+
+// BigSer is a BigDecimal serializer.
+var BigSer = bigSer{}
+
+// NewValidBigSer returns a new valid BigDecimal serializer.
+func NewValidBigSer(opts ...decopts.SetOption) bigSer {
+	o := decopts.Options{}
+	decopts.Apply(opts, &o)
+	return bigSer{o.PrecisionVl, o.ScaleVl}
+}
+
+type bigSer struct {
+	precisionVl com.Validator[int]
+	scaleVl     com.Validator[int8]
+}
+
+// Marshal fills bs with an encoded BigDecimal value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s bigSer) Marshal(v BigDecimal, bs []byte) (n int) {
+	n = marshalBigInt(unscaled(v), bs)
+	bs[n] = byte(v.Scale)
+	return n + 1
+}
+
+// Unmarshal parses an encoded BigDecimal value from bs.
+//
+// In addition to the BigDecimal value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice or a precision/scale validation error.
+func (s bigSer) Unmarshal(bs []byte) (v BigDecimal, n int, err error) {
+	if n, err = skipBigInt(bs); err != nil {
+		return
+	}
+	if len(bs) < n+1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	var (
+		data  = bs[:n]
+		scale = int8(bs[n])
+	)
+	n++
+	// The precision validator is applied to the lower bound first, so that too
+	// large values are rejected before decoding.
+	if err = validate(scale, s.scaleVl, s.precisionVl, func() int {
+		return minBigPrecision(data)
+	}); err != nil {
+		return
+	}
+	unscaled := unmarshalBigInt(data)
+	if s.precisionVl != nil {
+		if err = s.precisionVl.Validate(bigPrecision(unscaled)); err != nil {
+			return
+		}
+	}
+	v = BigDecimal{Unscaled: unscaled, Scale: scale}
+	return
+}
+
+// Size returns the size of an encoded BigDecimal value.
+func (s bigSer) Size(v BigDecimal) (size int) {
+	return sizeBigInt(unscaled(v)) + 1
+}
+
+// Skip skips an encoded BigDecimal value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s bigSer) Skip(bs []byte) (n int, err error) {
+	if n, err = skipBigInt(bs); err != nil {
+		return
+	}
+	if len(bs) < n+1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	n++
+	return
+}
+
+func unscaled(v BigDecimal) *big.Int {
+	if v.Unscaled == nil {
+		return new(big.Int)
+	}
+	return v.Unscaled
+}
+
+// A *big.Int value is encoded as ZigZag Varint of arbitrary length, which is
+// the same as varint.Int64 for values that fit int64.
+
+func marshalBigInt(v *big.Int, bs []byte) (n int) {
+	var (
+		ws = zigZag(v).Bits()
+		l  = sizeBigInt(v)
+	)
+	if len(bs) < l {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	for i := 0; i < l-1; i++ {
+		bs[i] = bits7(ws, uint(i*7)) | 0x80
+	}
+	bs[l-1] = bits7(ws, uint((l-1)*7))
+	return l
+}
+
+// unmarshalBigInt decodes a *big.Int value from bs, which must contain exactly
+// one checked encoded value.
+func unmarshalBigInt(bs []byte) (v *big.Int) {
+	ws := make([]big.Word, (len(bs)*7+bits.UintSize-1)/bits.UintSize)
+	for i, b := range bs {
+		var (
+			pos = uint(i * 7)
+			j   = pos / bits.UintSize
+			off = pos % bits.UintSize
+			u   = uint(b & 0x7f)
+		)
+		ws[j] |= big.Word(u << off)
+		if off > bits.UintSize-7 {
+			ws[j+1] |= big.Word(u >> (bits.UintSize - off))
+		}
+	}
+	v = new(big.Int).SetBits(ws)
+	neg := v.Bit(0) == 1
+	v.Rsh(v, 1)
+	if neg {
+		v.Neg(v.Add(v, big.NewInt(1)))
+	}
+	return
+}
+
+// minBigPrecision returns a lower bound of the precision of the *big.Int value
+// encoded in bs, which must contain exactly one checked encoded value. Unlike
+// decoding, it only scans bs.
+func minBigPrecision(bs []byte) int {
+	i := len(bs) - 1
+	for i > 0 && bs[i]&0x7f == 0 {
+		i--
+	}
+	// The bit length of the ZigZag value minus one is a lower bound of the bit
+	// length l of the absolute value.
+	l := i*7 + bits.Len8(bs[i]&0x7f) - 1
+	if l < 2 {
+		return 1
+	}
+	// The absolute value is at least 2^(l-1), so it has at least
+	// floor((l-1)*log10(2))+1 digits, 0.30102 is slightly less than log10(2).
+	return (l-1)*30102/100000 + 1
+}
+
+func sizeBigInt(v *big.Int) (size int) {
+	if v.Sign() == 0 {
+		return 1
+	}
+	l := v.BitLen() + 1
+	// -2^k is encoded as 2^(k+1)-1.
+	if v.Sign() < 0 && v.TrailingZeroBits() == uint(v.BitLen()-1) {
+		l--
+	}
+	return (l + 6) / 7
+}
+
+func skipBigInt(bs []byte) (n int, err error) {
+	for i, b := range bs {
+		if b < 0x80 {
+			return i + 1, nil
+		}
+	}
+	return len(bs), mus.ErrTooSmallByteSlice
+}
+
+// zigZag returns |v|*2 for v >= 0 and |v|*2-1 for v < 0.
+func zigZag(v *big.Int) (z *big.Int) {
+	z = new(big.Int).Abs(v)
+	z.Lsh(z, 1)
+	if v.Sign() < 0 {
+		z.Sub(z, big.NewInt(1))
+	}
+	return
+}
+
+// bits7 returns 7 bits of ws starting at pos.
+func bits7(ws []big.Word, pos uint) byte {
+	var (
+		i   = int(pos / bits.UintSize)
+		off = pos % bits.UintSize
+	)
+	if i >= len(ws) {
+		return 0
+	}
+	u := uint(ws[i]) >> off
+	if off > bits.UintSize-7 && i+1 < len(ws) {
+		u |= uint(ws[i+1]) << (bits.UintSize - off)
+	}
+	return byte(u & 0x7f)
+}
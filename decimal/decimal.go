@@ -0,0 +1,47 @@
+// Package decimal provides serializers for fixed-point decimal numbers, such
+// as monetary amounts.
+//
+// A decimal value is encoded as ZigZag Varint unscaled value + 1 byte scale,
+// and represents unscaled * 10^(-scale). Decimal and BigDecimal share the
+// encoding, so a value serialized by one of them can be deserialized by the
+// other, as long as the unscaled value fits int64.
+package decimal
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// Decimal is a fixed-point decimal number equal to Unscaled * 10^(-Scale).
+type Decimal struct {
+	Unscaled int64
+	Scale    int8
+}
+
+// BigDecimal is an arbitrary-precision fixed-point decimal number equal to
+// Unscaled * 10^(-Scale). A nil Unscaled is treated as 0.
+type BigDecimal struct {
+	Unscaled *big.Int
+	Scale    int8
+}
+
+// precision returns the number of decimal digits of v.
+func precision(v int64) int {
+	u := uint64(v)
+	if v < 0 {
+		u = -u
+	}
+	if u == 0 {
+		return 1
+	}
+	return len(strconv.FormatUint(u, 10))
+}
+
+// bigPrecision returns the number of decimal digits of v.
+func bigPrecision(v *big.Int) int {
+	l := len(v.Text(10))
+	if v.Sign() < 0 {
+		l--
+	}
+	return l
+}
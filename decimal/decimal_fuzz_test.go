@@ -0,0 +1,35 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzDecimal_Ser(f *testing.F) {
+	f.Fuzz(func(t *testing.T, unscaled int64, scale int8) {
+		v := Decimal{Unscaled: unscaled, Scale: scale}
+		test.Test([]Decimal{v}, Ser, t)
+		test.TestSkip([]Decimal{v}, Ser, t)
+	})
+}
+
+func FuzzDecimal_BigSer(f *testing.F) {
+	f.Fuzz(func(t *testing.T, mag []byte, neg bool, scale int8) {
+		u := new(big.Int).SetBytes(mag)
+		if neg {
+			u.Neg(u)
+		}
+		testBigSer([]BigDecimal{{Unscaled: u, Scale: scale}}, BigSer, t)
+	})
+}
+
+func FuzzDecimal_Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Ser.Unmarshal(bs)
+		Ser.Skip(bs)
+		BigSer.Unmarshal(bs)
+		BigSer.Skip(bs)
+	})
+}
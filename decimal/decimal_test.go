@@ -0,0 +1,247 @@
+package decimal
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	decopts "github.com/mus-format/mus-go/options/decimal"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+var (
+	errTooLargePrecision = errors.New("too large precision")
+	errTooLargeScale     = errors.New("too large scale")
+)
+
+func TestDecimal_Ser(t *testing.T) {
+	t.Run("Decimal serializer should succeed", func(t *testing.T) {
+		cases := []Decimal{
+			{},
+			{Unscaled: 12345, Scale: 2},
+			{Unscaled: -1, Scale: 18},
+			{Unscaled: 5, Scale: -3},
+			{Unscaled: math.MaxInt64, Scale: math.MaxInt8},
+			{Unscaled: math.MinInt64, Scale: math.MinInt8},
+		}
+		test.Test(cases, Ser, t)
+		test.TestSkip(cases, Ser, t)
+	})
+
+	t.Run("Decimal should be encoded as ZigZag Varint unscaled + scale",
+		func(t *testing.T) {
+			var (
+				v      = Decimal{Unscaled: -1999, Scale: 2}
+				wantBS = []byte{0x9d, 0x1f, 2}
+				bs     = make([]byte, Ser.Size(v))
+			)
+			Ser.Marshal(v, bs)
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no scale",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[Decimal]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly([]byte{2}, Ser, want, nil, t)
+			n, err := Ser.Skip([]byte{2})
+			asserterror.Equal(t, n, 1)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+
+	t.Run("Unmarshal should return ErrOverflow if the unscaled value does not fit int64",
+		func(t *testing.T) {
+			var (
+				v  = BigDecimal{Unscaled: new(big.Int).Lsh(big.NewInt(1), 64)}
+				bs = make([]byte, BigSer.Size(v))
+			)
+			BigSer.Marshal(v, bs)
+			_, _, err := Ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrOverflow)
+		})
+
+	t.Run("If scaleVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewValidSer(decopts.WithScaleValidator(
+					com.ValidatorFn[int8](validateScale)))
+				v  = Decimal{Unscaled: 1, Scale: 5}
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			want := test.UnmarshalResult[Decimal]{N: 2, Err: errTooLargeScale}
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("If precisionVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewValidSer(decopts.WithPrecisionValidator(
+					com.ValidatorFn[int](validatePrecision)))
+				v  = Decimal{Unscaled: -12345, Scale: 2}
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			want := test.UnmarshalResult[Decimal]{N: 4, Err: errTooLargePrecision}
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+			test.Test([]Decimal{{Unscaled: -9999, Scale: 2}}, ser, t)
+		})
+}
+
+func TestDecimal_BigSer(t *testing.T) {
+	t.Run("BigDecimal serializer should succeed", func(t *testing.T) {
+		huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+		testBigSer([]BigDecimal{
+			{Unscaled: big.NewInt(0)},
+			{Unscaled: big.NewInt(12345), Scale: 2},
+			{Unscaled: big.NewInt(-64), Scale: 1},
+			{Unscaled: big.NewInt(math.MinInt64), Scale: -1},
+			{Unscaled: new(big.Int).Lsh(big.NewInt(-1), 200), Scale: 3},
+			{Unscaled: new(big.Int).Lsh(big.NewInt(1), 200), Scale: 3},
+			{Unscaled: huge, Scale: 10},
+		}, BigSer, t)
+	})
+
+	t.Run("nil Unscaled should be encoded as 0", func(t *testing.T) {
+		var (
+			v      = BigDecimal{Scale: 2}
+			wantBS = []byte{0, 2}
+			bs     = make([]byte, BigSer.Size(v))
+		)
+		BigSer.Marshal(v, bs)
+		asserterror.EqualDeep(t, bs, wantBS)
+	})
+
+	t.Run("BigDecimal and Decimal should share the encoding", func(t *testing.T) {
+		for _, u := range []int64{0, 1, -1, 63, -64, 64, -65, 1 << 40,
+			math.MaxInt64, math.MinInt64} {
+			var (
+				v   = Decimal{Unscaled: u, Scale: 4}
+				bv  = BigDecimal{Unscaled: big.NewInt(u), Scale: 4}
+				bs  = make([]byte, Ser.Size(v))
+				bbs = make([]byte, BigSer.Size(bv))
+			)
+			Ser.Marshal(v, bs)
+			BigSer.Marshal(bv, bbs)
+			asserterror.EqualDeep(t, bbs, bs)
+			asserterror.Equal(t, varint.Int64.Size(u)+1, len(bbs))
+		}
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no varint end",
+		func(t *testing.T) {
+			for _, bs := range [][]byte{{}, {0x80, 0x80}, {1}} {
+				_, _, err := BigSer.Unmarshal(bs)
+				asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+				_, err = BigSer.Skip(bs)
+				asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			}
+		})
+
+	t.Run("If precisionVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				ser = NewValidBigSer(decopts.WithPrecisionValidator(
+					com.ValidatorFn[int](validatePrecision)),
+					decopts.WithScaleValidator(com.ValidatorFn[int8](validateScale)))
+				v  = BigDecimal{Unscaled: big.NewInt(-10000), Scale: 2}
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			_, n, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, errTooLargePrecision)
+			asserterror.Equal(t, n, len(bs))
+			testBigSer([]BigDecimal{{Unscaled: big.NewInt(-9999), Scale: 2}}, ser,
+				t)
+		})
+
+	t.Run("Valid Unmarshal should reject a too large value before decoding it",
+		func(t *testing.T) {
+			var (
+				ps  []int
+				ser = NewValidBigSer(decopts.WithPrecisionValidator(
+					com.ValidatorFn[int](func(p int) (err error) {
+						ps = append(ps, p)
+						if p > 38 {
+							err = errTooLargePrecision
+						}
+						return
+					})))
+				bs = bytes.Repeat([]byte{0xff}, 1<<20)
+			)
+			bs = append(bs, 0x01, 0)
+			_, n, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, errTooLargePrecision)
+			asserterror.Equal(t, n, len(bs))
+			asserterror.Equal(t, len(ps), 1)
+		})
+
+	t.Run("minBigPrecision should not exceed the precision", func(t *testing.T) {
+		for i := range 300 {
+			for _, v := range []*big.Int{
+				new(big.Int).Lsh(big.NewInt(1), uint(i)),
+				new(big.Int).Lsh(big.NewInt(-1), uint(i)),
+				new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(i)), nil),
+				new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10),
+					big.NewInt(int64(i)), nil), big.NewInt(1)),
+				new(big.Int).Neg(new(big.Int).Exp(big.NewInt(10),
+					big.NewInt(int64(i)), nil)),
+			} {
+				var (
+					bs  = make([]byte, sizeBigInt(v))
+					p   = bigPrecision(v)
+					min int
+				)
+				marshalBigInt(v, bs)
+				min = minBigPrecision(bs)
+				asserterror.Equal(t, min <= p, true)
+				asserterror.Equal(t, min >= p-1, true)
+			}
+		}
+	})
+}
+
+func validatePrecision(p int) (err error) {
+	if p > 4 {
+		err = errTooLargePrecision
+	}
+	return
+}
+
+func validateScale(s int8) (err error) {
+	if s > 2 {
+		err = errTooLargeScale
+	}
+	return
+}
+
+func testBigSer(cases []BigDecimal, ser mus.Serializer[BigDecimal],
+	t *testing.T) {
+	for i := range cases {
+		var (
+			size = ser.Size(cases[i])
+			bs   = make([]byte, size)
+		)
+		n := ser.Marshal(cases[i], bs)
+		asserterror.Equal(t, n, size)
+		v, n, err := ser.Unmarshal(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, size)
+		asserterror.Equal(t, v.Unscaled.Cmp(cases[i].Unscaled), 0)
+		asserterror.Equal(t, v.Scale, cases[i].Scale)
+		n, err = ser.Skip(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, size)
+	}
+}
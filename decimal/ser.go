@@ -0,0 +1,91 @@
+package decimal
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	decopts "github.com/mus-format/mus-go/options/decimal"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// Ser is a Decimal serializer.
+var Ser = ser{}
+
+// NewValidSer returns a new valid Decimal serializer.
+func NewValidSer(opts ...decopts.SetOption) ser {
+	o := decopts.Options{}
+	decopts.Apply(opts, &o)
+	return ser{o.PrecisionVl, o.ScaleVl}
+}
+
+type ser struct {
+	precisionVl com.Validator[int]
+	scaleVl     com.Validator[int8]
+}
+
+// Marshal fills bs with an encoded Decimal value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s ser) Marshal(v Decimal, bs []byte) (n int) {
+	n = varint.Int64.Marshal(v.Unscaled, bs)
+	bs[n] = byte(v.Scale)
+	return n + 1
+}
+
+// Unmarshal parses an encoded Decimal value from bs.
+//
+// In addition to the Decimal value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrOverflow, if the unscaled value
+// does not fit int64, or a precision/scale validation error.
+func (s ser) Unmarshal(bs []byte) (v Decimal, n int, err error) {
+	unscaled, n, err := varint.Int64.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if len(bs) < n+1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	scale := int8(bs[n])
+	n++
+	if err = validate(scale, s.scaleVl, s.precisionVl, func() int {
+		return precision(unscaled)
+	}); err != nil {
+		return
+	}
+	v = Decimal{Unscaled: unscaled, Scale: scale}
+	return
+}
+
+// Size returns the size of an encoded Decimal value.
+func (s ser) Size(v Decimal) (size int) {
+	return varint.Int64.Size(v.Unscaled) + 1
+}
+
+// Skip skips an encoded Decimal value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s ser) Skip(bs []byte) (n int, err error) {
+	if n, err = varint.Int64.Skip(bs); err != nil {
+		return
+	}
+	if len(bs) < n+1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	n++
+	return
+}
+
+func validate(scale int8, scaleVl com.Validator[int8],
+	precisionVl com.Validator[int], precision func() int) (err error) {
+	if scaleVl != nil {
+		if err = scaleVl.Validate(scale); err != nil {
+			return
+		}
+	}
+	if precisionVl != nil {
+		err = precisionVl.Validate(precision())
+	}
+	return
+}
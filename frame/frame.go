@@ -0,0 +1,6 @@
+// Package frame provides length-delimited framing of MUS values for streams.
+// Each value is written as varint.PositiveInt payload length + payload.
+package frame
+
+// DefaultMaxSize is the default maximum payload size of a frame, 4 MiB.
+const DefaultMaxSize = 4 << 20
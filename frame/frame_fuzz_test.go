@@ -0,0 +1,40 @@
+package frame
+
+import (
+	"bytes"
+	"testing"
+
+	frmopts "github.com/mus-format/mus-go/options/frame"
+	"github.com/mus-format/mus-go/ord"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+func FuzzFrame_WriterReader(f *testing.F) {
+	f.Add("", "abc")
+	f.Add("host-1", "host-2")
+	f.Fuzz(func(t *testing.T, v1, v2 string) {
+		var (
+			buf bytes.Buffer
+			w   = NewWriter[string](&buf, ord.String)
+		)
+		w.Write(v1)
+		w.Write(v2)
+		r := NewReader[string](&buf, ord.String)
+		for _, want := range []string{v1, v2} {
+			v, err := r.Read()
+			assertfatal.EqualError(t, err, nil)
+			asserterror.Equal(t, v, want)
+		}
+	})
+}
+
+func FuzzFrame_Reader(f *testing.F) {
+	f.Add([]byte{4, 3, 'a', 'b', 'c'})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		sc := NewScanner[string](bytes.NewReader(bs), ord.String,
+			frmopts.WithMaxSize(1000))
+		for sc.Scan() {
+		}
+	})
+}
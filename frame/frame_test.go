@@ -0,0 +1,183 @@
+package frame
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	frmopts "github.com/mus-format/mus-go/options/frame"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+func TestFrame_Writer(t *testing.T) {
+	t.Run("Write should write length + payload", func(t *testing.T) {
+		var (
+			buf    bytes.Buffer
+			w      = NewWriter[string](&buf, ord.String)
+			wantBS = []byte{4, 3, 'a', 'b', 'c', 1, 0}
+		)
+		n, err := w.Write("abc")
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, 5)
+		n, err = w.Write("")
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, 2)
+		asserterror.EqualDeep(t, buf.Bytes(), wantBS)
+	})
+
+	t.Run("Write should return an io.Writer error", func(t *testing.T) {
+		var (
+			wantErr = errors.New("write error")
+			w       = NewWriter[string](errWriter{wantErr}, ord.String)
+		)
+		_, err := w.Write("abc")
+		asserterror.EqualError(t, err, wantErr)
+	})
+
+	t.Run("Write should return com.ErrTooLargeLength if the payload exceeds the maximum size",
+		func(t *testing.T) {
+			var (
+				buf bytes.Buffer
+				w   = NewWriter[string](&buf, ord.String, frmopts.WithMaxSize(3))
+			)
+			n, err := w.Write("abc")
+			asserterror.EqualError(t, err, com.ErrTooLargeLength)
+			asserterror.Equal(t, n, 0)
+			asserterror.Equal(t, buf.Len(), 0)
+
+			_, err = w.Write("ab")
+			assertfatal.EqualError(t, err, nil)
+		})
+}
+
+func TestFrame_Reader(t *testing.T) {
+	t.Run("Reader should read frames written by Writer", func(t *testing.T) {
+		var (
+			buf  bytes.Buffer
+			w    = NewWriter[[]int](&buf, ord.NewSliceSer(varint.Int))
+			want = [][]int{{1, 2, 3}, {}, {-1000, 1000000}}
+		)
+		for i := range want {
+			_, err := w.Write(want[i])
+			assertfatal.EqualError(t, err, nil)
+		}
+		r := NewReader[[]int](iotestReader{&buf}, ord.NewSliceSer(varint.Int))
+		for i := range want {
+			v, err := r.Read()
+			assertfatal.EqualError(t, err, nil)
+			asserterror.EqualDeep(t, v, want[i])
+		}
+		_, err := r.Read()
+		asserterror.EqualError(t, err, io.EOF)
+	})
+
+	t.Run("Read should return io.ErrUnexpectedEOF if the stream ends in the middle of the length",
+		func(t *testing.T) {
+			r := NewReader[string](bytes.NewReader([]byte{0x80}), ord.String)
+			_, err := r.Read()
+			asserterror.EqualError(t, err, io.ErrUnexpectedEOF)
+		})
+
+	t.Run("Read should return io.ErrUnexpectedEOF if the stream ends in the middle of the payload",
+		func(t *testing.T) {
+			r := NewReader[string](bytes.NewReader([]byte{4, 3, 'a'}), ord.String)
+			_, err := r.Read()
+			asserterror.EqualError(t, err, io.ErrUnexpectedEOF)
+
+			r = NewReader[string](bytes.NewReader([]byte{4}), ord.String)
+			_, err = r.Read()
+			asserterror.EqualError(t, err, io.ErrUnexpectedEOF)
+		})
+
+	t.Run("Read should return com.ErrTooLargeLength if the payload is larger than the maximum size",
+		func(t *testing.T) {
+			r := NewReader[string](bytes.NewReader([]byte{4, 3, 'a', 'b', 'c'}),
+				ord.String, frmopts.WithMaxSize(3))
+			_, err := r.Read()
+			asserterror.EqualError(t, err, com.ErrTooLargeLength)
+		})
+
+	t.Run("Read should return com.ErrNegativeLength if the length is negative",
+		func(t *testing.T) {
+			bs := make([]byte, varint.PositiveInt.Size(-1))
+			varint.PositiveInt.Marshal(-1, bs)
+			r := NewReader[string](bytes.NewReader(bs), ord.String)
+			_, err := r.Read()
+			asserterror.EqualError(t, err, com.ErrNegativeLength)
+		})
+
+	t.Run("Read should return com.ErrOverflow if the length is too long",
+		func(t *testing.T) {
+			bs := bytes.Repeat([]byte{0xff}, 11)
+			r := NewReader[string](bytes.NewReader(bs), ord.String)
+			_, err := r.Read()
+			asserterror.EqualError(t, err, com.ErrOverflow)
+		})
+
+	t.Run("Read should return com.ErrWrongFormat if the payload is not consumed entirely",
+		func(t *testing.T) {
+			r := NewReader[string](bytes.NewReader([]byte{3, 1, 'a', 'b'}),
+				ord.String)
+			_, err := r.Read()
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+		})
+
+	t.Run("Read should return an unmarshalling error", func(t *testing.T) {
+		r := NewReader[string](bytes.NewReader([]byte{2, 3, 'a'}), ord.String)
+		_, err := r.Read()
+		asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+	})
+}
+
+func TestFrame_Scanner(t *testing.T) {
+	t.Run("Scanner should iterate over all frames", func(t *testing.T) {
+		var (
+			buf  bytes.Buffer
+			w    = NewWriter[string](&buf, ord.String)
+			want = []string{"host-1", "", "host-2"}
+			got  []string
+		)
+		for i := range want {
+			w.Write(want[i])
+		}
+		sc := NewScanner[string](&buf, ord.String)
+		for sc.Scan() {
+			got = append(got, sc.Value())
+		}
+		asserterror.EqualError(t, sc.Err(), nil)
+		asserterror.EqualDeep(t, got, want)
+		asserterror.Equal(t, sc.Scan(), false)
+	})
+
+	t.Run("Err should return the first non-EOF error", func(t *testing.T) {
+		sc := NewScanner[string](bytes.NewReader([]byte{2, 1, 'a', 4}),
+			ord.String)
+		asserterror.Equal(t, sc.Scan(), true)
+		asserterror.Equal(t, sc.Value(), "a")
+		asserterror.Equal(t, sc.Scan(), false)
+		asserterror.EqualError(t, sc.Err(), io.ErrUnexpectedEOF)
+	})
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w errWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+// iotestReader hides io.ByteReader of the underlying reader.
+type iotestReader struct {
+	r io.Reader
+}
+
+func (r iotestReader) Read(p []byte) (int, error) {
+	return r.r.Read(p)
+}
@@ -0,0 +1,108 @@
+package frame
+
+import (
+	"bufio"
+	"io"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	frmopts "github.com/mus-format/mus-go/options/frame"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewReader returns a new Reader, which reads values encoded by ser from r.
+// If r does not implement io.ByteReader, it is wrapped with bufio.Reader.
+//
+// The maximum payload size of a frame is DefaultMaxSize, to change it use
+// frmopts.WithMaxSize.
+func NewReader[T any](r io.Reader, ser mus.Serializer[T],
+	opts ...frmopts.SetOption,
+) *Reader[T] {
+	o := frmopts.Options{MaxSize: DefaultMaxSize}
+	frmopts.Apply(opts, &o)
+
+	br, ok := r.(byteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Reader[T]{r: br, ser: ser, maxSize: o.MaxSize}
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// Reader reads frames from an io.Reader. Its buffer is reused between frames,
+// so values that reference the buffer (like those unmarshalled by the unsafe
+// package) are only valid until the next Read. A Reader is not safe for
+// concurrent use.
+type Reader[T any] struct {
+	r       byteReader
+	ser     mus.Serializer[T]
+	maxSize int
+	buf     []byte
+}
+
+// Read reads and unmarshals the next frame.
+//
+// Returns io.EOF if there are no more frames. In addition, it may return
+// io.ErrUnexpectedEOF, if the stream ends in the middle of a frame,
+// com.ErrNegativeLength, com.ErrTooLargeLength, if the payload is larger than
+// the maximum size, com.ErrWrongFormat, if the payload is not consumed
+// entirely, com.ErrOverflow, an underlying io.Reader error, or an unmarshalling
+// error.
+func (r *Reader[T]) Read() (v T, err error) {
+	size, err := r.readSize()
+	if err != nil {
+		return
+	}
+	if cap(r.buf) < size {
+		r.buf = make([]byte, size)
+	}
+	bs := r.buf[:size]
+	if _, err = io.ReadFull(r.r, bs); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return
+	}
+	v, n, err := r.ser.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if n != size {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+func (r *Reader[T]) readSize() (size int, err error) {
+	var (
+		bs [com.Uint64MaxVarintLen]byte
+		i  int
+	)
+	for {
+		if bs[i], err = r.r.ReadByte(); err != nil {
+			if err == io.EOF && i > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+		if bs[i] < 0x80 || i == len(bs)-1 {
+			break
+		}
+		i++
+	}
+	if size, _, err = varint.PositiveInt.Unmarshal(bs[:i+1]); err != nil {
+		return
+	}
+	if size < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if size > r.maxSize {
+		err = com.ErrTooLargeLength
+	}
+	return
+}
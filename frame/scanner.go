@@ -0,0 +1,55 @@
+package frame
+
+import (
+	"io"
+
+	"github.com/mus-format/mus-go"
+	frmopts "github.com/mus-format/mus-go/options/frame"
+)
+
+// NewScanner returns a new Scanner, which reads values encoded by ser from r.
+// Options are the same as for NewReader.
+func NewScanner[T any](r io.Reader, ser mus.Serializer[T],
+	opts ...frmopts.SetOption,
+) *Scanner[T] {
+	return &Scanner[T]{r: NewReader(r, ser, opts...)}
+}
+
+// Scanner provides a convenient way of iterating over frames:
+//
+//	for sc.Scan() {
+//		v := sc.Value()
+//		...
+//	}
+//	if err := sc.Err(); err != nil {
+//		...
+//	}
+type Scanner[T any] struct {
+	r   *Reader[T]
+	v   T
+	err error
+}
+
+// Scan reads the next frame, which will then be available through the Value
+// method. It returns false when the scan stops, either by reaching the end of
+// the stream or an error.
+func (s *Scanner[T]) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	s.v, s.err = s.r.Read()
+	return s.err == nil
+}
+
+// Value returns the most recent value read by Scan.
+func (s *Scanner[T]) Value() T {
+	return s.v
+}
+
+// Err returns the first non-EOF error that was encountered by the Scanner.
+func (s *Scanner[T]) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
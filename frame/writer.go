@@ -0,0 +1,53 @@
+package frame
+
+import (
+	"io"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	frmopts "github.com/mus-format/mus-go/options/frame"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewWriter returns a new Writer, which writes values encoded by ser to w.
+//
+// The maximum payload size of a frame is DefaultMaxSize, to change it use
+// frmopts.WithMaxSize. It should not exceed the maximum size of the Reader.
+func NewWriter[T any](w io.Writer, ser mus.Serializer[T],
+	opts ...frmopts.SetOption,
+) *Writer[T] {
+	o := frmopts.Options{MaxSize: DefaultMaxSize}
+	frmopts.Apply(opts, &o)
+
+	return &Writer[T]{w: w, ser: ser, maxSize: o.MaxSize}
+}
+
+// Writer writes frames to an io.Writer. Its buffer is reused between frames,
+// so a Writer is not safe for concurrent use.
+type Writer[T any] struct {
+	w       io.Writer
+	ser     mus.Serializer[T]
+	maxSize int
+	buf     []byte
+}
+
+// Write writes v as a single frame.
+//
+// Returns the number of written bytes and any error returned by the
+// underlying io.Writer. If the payload is larger than the maximum size,
+// nothing is written and com.ErrTooLargeLength is returned.
+func (w *Writer[T]) Write(v T) (n int, err error) {
+	size := w.ser.Size(v)
+	if size > w.maxSize {
+		err = com.ErrTooLargeLength
+		return
+	}
+	l := varint.PositiveInt.Size(size) + size
+	if cap(w.buf) < l {
+		w.buf = make([]byte, l)
+	}
+	bs := w.buf[:l]
+	n = varint.PositiveInt.Marshal(size, bs)
+	w.ser.Marshal(v, bs[n:])
+	return w.w.Write(bs)
+}
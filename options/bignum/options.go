@@ -0,0 +1,30 @@
+// Package bnopts provides options for customizing arbitrary-precision number
+// serialization.
+package bnopts
+
+import (
+	com "github.com/mus-format/common-go"
+)
+
+// Options for the bignum serializers.
+type Options struct {
+	BitLenVl com.Validator[int]
+}
+
+type SetOption func(o *Options)
+
+// WithBitLenValidator sets a validator of the bit length. For *big.Int and
+// *big.Rat values, it is applied to the bit length of each magnitude, for
+// *big.Float values - to the precision. The validation is performed before
+// any allocation.
+func WithBitLenValidator(bitLenVl com.Validator[int]) SetOption {
+	return func(o *Options) { o.BitLenVl = bitLenVl }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
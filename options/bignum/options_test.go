@@ -0,0 +1,22 @@
+package bnopts
+
+import (
+	"testing"
+
+	cmock "github.com/mus-format/common-go/test/mock"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o            = Options{}
+		wantBitLenVl = cmock.NewValidator[int]()
+	)
+	Apply([]SetOption{
+		WithBitLenValidator(wantBitLenVl),
+	}, &o)
+
+	if o.BitLenVl != wantBitLenVl {
+		t.Errorf("unexpected BitLenVl, want %v actual %v", wantBitLenVl,
+			o.BitLenVl)
+	}
+}
@@ -0,0 +1,26 @@
+// Package chkopts provides options for customizing checksummed value
+// serialization.
+package chkopts
+
+import "hash"
+
+// Options for the checksummed serializer.
+type Options struct {
+	NewHash func() hash.Hash
+}
+
+type SetOption func(o *Options)
+
+// WithHash sets a constructor of the hash used to calculate checksums, for
+// example crc32.NewIEEE or fnv.New64a.
+func WithHash(newHash func() hash.Hash) SetOption {
+	return func(o *Options) { o.NewHash = newHash }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
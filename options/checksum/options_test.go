@@ -0,0 +1,24 @@
+package chkopts
+
+import (
+	"hash"
+	"hash/fnv"
+	"testing"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o           = Options{}
+		wantNewHash = func() hash.Hash { return fnv.New64a() }
+	)
+	Apply([]SetOption{
+		WithHash(wantNewHash),
+	}, &o)
+
+	if o.NewHash == nil {
+		t.Fatal("unexpected NewHash, want not nil")
+	}
+	if size := o.NewHash().Size(); size != 8 {
+		t.Errorf("unexpected hash size, want 8 actual %v", size)
+	}
+}
@@ -0,0 +1,35 @@
+// Package cmpopts provides options for customizing compressed value
+// serialization.
+package cmpopts
+
+import (
+	com "github.com/mus-format/common-go"
+)
+
+// Options for the compressed serializer.
+type Options struct {
+	MaxLen int
+	LenVl  com.Validator[int]
+}
+
+type SetOption func(o *Options)
+
+// WithMaxLen sets the maximum decompressed length. It is checked before the
+// decompression buffer is allocated.
+func WithMaxLen(maxLen int) SetOption {
+	return func(o *Options) { o.MaxLen = maxLen }
+}
+
+// WithLenValidator sets a validator of the decompressed length. It is applied
+// before the decompression buffer is allocated.
+func WithLenValidator(lenVl com.Validator[int]) SetOption {
+	return func(o *Options) { o.LenVl = lenVl }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package cmpopts
+
+import (
+	"testing"
+
+	cmock "github.com/mus-format/common-go/test/mock"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o          = Options{}
+		wantMaxLen = 10
+		wantLenVl  = cmock.NewValidator[int]()
+	)
+	Apply([]SetOption{
+		WithMaxLen(wantMaxLen),
+		WithLenValidator(wantLenVl),
+	}, &o)
+
+	if o.MaxLen != wantMaxLen {
+		t.Errorf("unexpected MaxLen, want %v actual %v", wantMaxLen, o.MaxLen)
+	}
+	if o.LenVl != wantLenVl {
+		t.Errorf("unexpected LenVl, want %v actual %v", wantLenVl, o.LenVl)
+	}
+}
@@ -0,0 +1,36 @@
+// Package decopts provides options for customizing decimal serialization.
+package decopts
+
+import (
+	com "github.com/mus-format/common-go"
+)
+
+// Options for the decimal serializers.
+type Options struct {
+	PrecisionVl com.Validator[int]
+	ScaleVl     com.Validator[int8]
+}
+
+type SetOption func(o *Options)
+
+// WithPrecisionValidator sets a validator of the precision - the number of
+// decimal digits of the unscaled value. For BigDecimal values it is first
+// applied to a lower bound of the precision estimated from the encoded length,
+// so that too large values are rejected before decoding. Thus it should limit
+// the maximum precision.
+func WithPrecisionValidator(precisionVl com.Validator[int]) SetOption {
+	return func(o *Options) { o.PrecisionVl = precisionVl }
+}
+
+// WithScaleValidator sets a validator of the scale.
+func WithScaleValidator(scaleVl com.Validator[int8]) SetOption {
+	return func(o *Options) { o.ScaleVl = scaleVl }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
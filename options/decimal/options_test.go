@@ -0,0 +1,27 @@
+package decopts
+
+import (
+	"testing"
+
+	cmock "github.com/mus-format/common-go/test/mock"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o               = Options{}
+		wantPrecisionVl = cmock.NewValidator[int]()
+		wantScaleVl     = cmock.NewValidator[int8]()
+	)
+	Apply([]SetOption{
+		WithPrecisionValidator(wantPrecisionVl),
+		WithScaleValidator(wantScaleVl),
+	}, &o)
+
+	if o.PrecisionVl != wantPrecisionVl {
+		t.Errorf("unexpected PrecisionVl, want %v actual %v", wantPrecisionVl,
+			o.PrecisionVl)
+	}
+	if o.ScaleVl != wantScaleVl {
+		t.Errorf("unexpected ScaleVl, want %v actual %v", wantScaleVl, o.ScaleVl)
+	}
+}
@@ -0,0 +1,43 @@
+// Package dltopts provides options for customizing delta slice serialization.
+package dltopts
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+// Options for the delta slice serializer.
+type Options[T any] struct {
+	LenSer       mus.Serializer[int]
+	LenVl        com.Validator[int]
+	ElemVl       com.Validator[T]
+	DeltaOfDelta bool
+}
+
+type SetOption[T any] func(o *Options[T])
+
+func WithLenSer[T any](lenSer mus.Serializer[int]) SetOption[T] {
+	return func(o *Options[T]) { o.LenSer = lenSer }
+}
+
+func WithLenValidator[T any](lenVl com.Validator[int]) SetOption[T] {
+	return func(o *Options[T]) { o.LenVl = lenVl }
+}
+
+func WithElemValidator[T any](elemVl com.Validator[T]) SetOption[T] {
+	return func(o *Options[T]) { o.ElemVl = elemVl }
+}
+
+// WithDeltaOfDelta enables delta-of-delta (Gorilla-style) encoding, which
+// suits values with a nearly constant step, such as regular timestamps.
+func WithDeltaOfDelta[T any]() SetOption[T] {
+	return func(o *Options[T]) { o.DeltaOfDelta = true }
+}
+
+func Apply[T any](opts []SetOption[T], o *Options[T]) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package dltopts
+
+import (
+	"testing"
+
+	cmock "github.com/mus-format/common-go/test/mock"
+	"github.com/mus-format/mus-go/test/mock"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o          = Options[any]{}
+		wantLenSer = mock.NewSerializer[int]()
+		wantLenVl  = cmock.NewValidator[int]()
+		wantElemVl = cmock.NewValidator[any]()
+	)
+	Apply([]SetOption[any]{
+		WithLenSer[any](wantLenSer),
+		WithLenValidator[any](wantLenVl),
+		WithElemValidator[any](wantElemVl),
+		WithDeltaOfDelta[any](),
+	}, &o)
+
+	if o.LenSer != wantLenSer {
+		t.Errorf("unexpected LenSer, want %v actual %v", wantLenSer, o.LenSer)
+	}
+
+	if o.LenVl != wantLenVl {
+		t.Errorf("unexpected LenVl, want %v actual %v", wantLenVl, o.LenVl)
+	}
+
+	if o.ElemVl != wantElemVl {
+		t.Errorf("unexpected ElemVl, want %v actual %v", wantElemVl, o.ElemVl)
+	}
+
+	if !o.DeltaOfDelta {
+		t.Error("unexpected DeltaOfDelta, want true actual false")
+	}
+}
@@ -0,0 +1,23 @@
+// Package frmopts provides options for customizing frame reading and
+// writing.
+package frmopts
+
+// Options for the frame reader and writer.
+type Options struct {
+	MaxSize int
+}
+
+type SetOption func(o *Options)
+
+// WithMaxSize sets the maximum payload size of a frame.
+func WithMaxSize(maxSize int) SetOption {
+	return func(o *Options) { o.MaxSize = maxSize }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package frmopts
+
+import "testing"
+
+func TestOptions(t *testing.T) {
+	var (
+		o           = Options{}
+		wantMaxSize = 10
+	)
+	Apply([]SetOption{
+		WithMaxSize(wantMaxSize),
+	}, &o)
+
+	if o.MaxSize != wantMaxSize {
+		t.Errorf("unexpected MaxSize, want %v actual %v", wantMaxSize, o.MaxSize)
+	}
+}
@@ -0,0 +1,29 @@
+// Package qntopts provides options for customizing quantized float
+// serialization.
+package qntopts
+
+import (
+	com "github.com/mus-format/common-go"
+)
+
+// Options for the quantized serializer.
+type Options struct {
+	LossVl com.Validator[float64]
+}
+
+type SetOption func(o *Options)
+
+// WithLossValidator sets a validator of the precision loss - the absolute
+// difference between a marshalled value and the one that will be
+// unmarshalled. It is applied by the ValidateLoss method of the serializer.
+func WithLossValidator(lossVl com.Validator[float64]) SetOption {
+	return func(o *Options) { o.LossVl = lossVl }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package qntopts
+
+import (
+	"testing"
+
+	cmock "github.com/mus-format/common-go/test/mock"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o          = Options{}
+		wantLossVl = cmock.NewValidator[float64]()
+	)
+	Apply([]SetOption{
+		WithLossValidator(wantLossVl),
+	}, &o)
+
+	if o.LossVl != wantLossVl {
+		t.Errorf("unexpected LossVl, want %v actual %v", wantLossVl, o.LossVl)
+	}
+}
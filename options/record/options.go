@@ -0,0 +1,27 @@
+// Package recopts provides options for customizing record serialization.
+package recopts
+
+// UnknownSink receives fields with unknown numbers during unmarshalling. data
+// references the unmarshalled byte slice.
+type UnknownSink[T any] func(v *T, num int, data []byte)
+
+// Options for the record serializer.
+type Options[T any] struct {
+	UnknownSink UnknownSink[T]
+}
+
+type SetOption[T any] func(o *Options[T])
+
+// WithUnknownSink sets a sink for unknown fields, for example to keep them in
+// the struct and write them back later.
+func WithUnknownSink[T any](sink UnknownSink[T]) SetOption[T] {
+	return func(o *Options[T]) { o.UnknownSink = sink }
+}
+
+func Apply[T any](opts []SetOption[T], o *Options[T]) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
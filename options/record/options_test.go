@@ -0,0 +1,21 @@
+package recopts
+
+import "testing"
+
+func TestOptions(t *testing.T) {
+	var (
+		o      = Options[int]{}
+		called bool
+	)
+	Apply([]SetOption[int]{
+		WithUnknownSink(func(v *int, num int, data []byte) { called = true }),
+	}, &o)
+
+	if o.UnknownSink == nil {
+		t.Fatal("unexpected UnknownSink, want not nil")
+	}
+	o.UnknownSink(nil, 0, nil)
+	if !called {
+		t.Error("UnknownSink was not called")
+	}
+}
@@ -0,0 +1,34 @@
+// Package sealopts provides options for customizing sealed value
+// serialization.
+package sealopts
+
+import "io"
+
+// Options for the sealed serializer.
+type Options struct {
+	AAD  []byte
+	Rand io.Reader
+}
+
+type SetOption func(o *Options)
+
+// WithAAD sets additional authenticated data, for example the encoded DTM of
+// the value. It is authenticated but not stored, so the same data must be
+// provided for unmarshalling.
+func WithAAD(aad []byte) SetOption {
+	return func(o *Options) { o.AAD = aad }
+}
+
+// WithRand sets a source of random nonces. By default crypto/rand.Reader is
+// used.
+func WithRand(rand io.Reader) SetOption {
+	return func(o *Options) { o.Rand = rand }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package sealopts
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o        = Options{}
+		wantAAD  = []byte{1, 2, 3}
+		wantRand = strings.NewReader("rand")
+	)
+	Apply([]SetOption{
+		WithAAD(wantAAD),
+		WithRand(wantRand),
+	}, &o)
+
+	if !bytes.Equal(o.AAD, wantAAD) {
+		t.Errorf("unexpected AAD, want %v actual %v", wantAAD, o.AAD)
+	}
+
+	if o.Rand != wantRand {
+		t.Errorf("unexpected Rand, want %v actual %v", wantRand, o.Rand)
+	}
+}
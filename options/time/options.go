@@ -0,0 +1,31 @@
+// Package tmopts provides options for customizing time.Time serialization.
+package tmopts
+
+import "time"
+
+// Options for the time.Time serializer.
+type Options struct {
+	Epoch time.Time
+	UTC   bool
+}
+
+type SetOption func(o *Options)
+
+// WithEpoch sets a custom epoch, timestamps are encoded relative to it. An
+// epoch close to the encoded values makes the encoding shorter.
+func WithEpoch(epoch time.Time) SetOption {
+	return func(o *Options) { o.Epoch = epoch }
+}
+
+// WithUTC makes the deserialized values to be in UTC.
+func WithUTC() SetOption {
+	return func(o *Options) { o.UTC = true }
+}
+
+func Apply(opts []SetOption, o *Options) {
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i](o)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package tmopts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOptions(t *testing.T) {
+	var (
+		o         = Options{}
+		wantEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	Apply([]SetOption{
+		WithEpoch(wantEpoch),
+		WithUTC(),
+	}, &o)
+
+	if !o.Epoch.Equal(wantEpoch) {
+		t.Errorf("unexpected Epoch, want %v actual %v", wantEpoch, o.Epoch)
+	}
+
+	if !o.UTC {
+		t.Error("unexpected UTC, want true actual false")
+	}
+}
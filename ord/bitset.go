@@ -0,0 +1,74 @@
+package ord
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+// NewBitsetSer returns a new fixed-width bitset serializer for uint64-backed
+// bitsets of the given bit length. Bit i is stored in v[i/64] at position
+// i%64.
+//
+// A bitset is encoded without a length, as (bitLen + 7) / 8 bytes. Words
+// missing from a short bitset are encoded as zeros, bits beyond bitLen are
+// ignored.
+//
+// Panics if bitLen is negative.
+func NewBitsetSer(bitLen int) bitsetSer {
+	if bitLen < 0 {
+		panic(com.ErrNegativeLength)
+	}
+	return bitsetSer{bitLen}
+}
+
+type bitsetSer struct {
+	bitLen int
+}
+
+// Marshal fills bs with an encoded bitset value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s bitsetSer) Marshal(v []uint64, bs []byte) (n int) {
+	n = SizePackedBools(s.bitLen)
+	if len(bs) < n {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	clear(bs[:n])
+	for i := range n {
+		if w := i >> 3; w < len(v) {
+			bs[i] = byte(v[w] >> ((i & 7) << 3))
+		}
+	}
+	if rem := s.bitLen & 7; rem != 0 {
+		bs[n-1] &= 1<<rem - 1
+	}
+	return
+}
+
+// Unmarshal parses an encoded bitset value from bs.
+//
+// In addition to the bitset value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice or com.ErrWrongFormat.
+func (s bitsetSer) Unmarshal(bs []byte) (v []uint64, n int, err error) {
+	if n, err = SkipPackedBools(s.bitLen, bs); err != nil {
+		return
+	}
+	v = make([]uint64, (s.bitLen+63)>>6)
+	for i := range n {
+		v[i>>3] |= uint64(bs[i]) << ((i & 7) << 3)
+	}
+	return
+}
+
+// Size returns the size of an encoded bitset value.
+func (s bitsetSer) Size(v []uint64) (size int) {
+	return SizePackedBools(s.bitLen)
+}
+
+// Skip skips an encoded bitset value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrWrongFormat.
+func (s bitsetSer) Skip(bs []byte) (n int, err error) {
+	return SkipPackedBools(s.bitLen, bs)
+}
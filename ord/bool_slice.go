@@ -0,0 +1,202 @@
+package ord
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// PackedBoolSlice is the packed bool slice serializer.
+var PackedBoolSlice = NewPackedBoolSliceSer()
+
+// NewPackedBoolSliceSer returns a new packed bool slice serializer, which
+// encodes a slice as length + 8 flags per byte. To specify a length or element
+// validator, use NewValidPackedBoolSliceSer instead.
+func NewPackedBoolSliceSer(opts ...slopts.SetOption[bool]) packedBoolSliceSer {
+	o := slopts.Options[bool]{}
+	slopts.Apply(opts, &o)
+
+	return newPackedBoolSliceSer(o)
+}
+
+// NewValidPackedBoolSliceSer returns a new valid packed bool slice serializer.
+func NewValidPackedBoolSliceSer(opts ...slopts.SetOption[bool]) validPackedBoolSliceSer {
+	o := slopts.Options[bool]{}
+	slopts.Apply(opts, &o)
+
+	return validPackedBoolSliceSer{
+		packedBoolSliceSer: newPackedBoolSliceSer(o),
+		lenVl:              o.LenVl,
+		elemVl:             o.ElemVl,
+	}
+}
+
+func newPackedBoolSliceSer(o slopts.Options[bool]) packedBoolSliceSer {
+	var lenSer mus.Serializer[int] = varint.PositiveInt
+	if o.LenSer != nil {
+		lenSer = o.LenSer
+	}
+	return packedBoolSliceSer{lenSer}
+}
+
+type packedBoolSliceSer struct {
+	lenSer mus.Serializer[int]
+}
+
+// Marshal fills bs with an encoded bool slice value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s packedBoolSliceSer) Marshal(v []bool, bs []byte) (n int) {
+	n = s.lenSer.Marshal(len(v), bs)
+	return n + MarshalPackedBools(v, bs[n:])
+}
+
+// Unmarshal parses an encoded bool slice value from bs.
+//
+// In addition to the bool slice value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice, com.ErrNegativeLength,
+// com.ErrWrongFormat, or a length unmarshalling error.
+func (s packedBoolSliceSer) Unmarshal(bs []byte) (v []bool, n int, err error) {
+	return unmarshalPackedBoolSlice(bs, s.lenSer, nil, nil)
+}
+
+// Size returns the size of an encoded bool slice value.
+func (s packedBoolSliceSer) Size(v []bool) (size int) {
+	length := len(v)
+	return s.lenSer.Size(length) + SizePackedBools(length)
+}
+
+// Skip skips an encoded bool slice value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrWrongFormat, or a
+// length unmarshalling error.
+func (s packedBoolSliceSer) Skip(bs []byte) (n int, err error) {
+	length, n, err := s.lenSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	n1, err := SkipPackedBools(length, bs[n:])
+	n += n1
+	return
+}
+
+// valid -----------------------------------------------------------------------
+
+type validPackedBoolSliceSer struct {
+	packedBoolSliceSer
+	lenVl  com.Validator[int]
+	elemVl com.Validator[bool]
+}
+
+// Unmarshal parses an encoded bool slice value from bs.
+//
+// In addition to the bool slice value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice, com.ErrNegativeLength,
+// com.ErrWrongFormat, a length unmarshalling error, or a length/element
+// validation error.
+func (s validPackedBoolSliceSer) Unmarshal(bs []byte) (v []bool, n int,
+	err error,
+) {
+	return unmarshalPackedBoolSlice(bs, s.lenSer, s.lenVl, s.elemVl)
+}
+
+// MarshalPackedBools fills bs with v packed 8 flags per byte, without a
+// length. The first flag occupies the least significant bit of the first byte.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func MarshalPackedBools(v []bool, bs []byte) (n int) {
+	n = SizePackedBools(len(v))
+	if len(bs) < n {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	clear(bs[:n])
+	for i, e := range v {
+		if e {
+			bs[i>>3] |= 1 << (i & 7)
+		}
+	}
+	return
+}
+
+// UnmarshalPackedBools fills v with flags packed by MarshalPackedBools.
+//
+// In addition to the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrWrongFormat, if the unused bits of the
+// last byte are not zero.
+func UnmarshalPackedBools(bs []byte, v []bool) (n int, err error) {
+	if n, err = SkipPackedBools(len(v), bs); err != nil {
+		return
+	}
+	for i := range v {
+		v[i] = bs[i>>3]&(1<<(i&7)) != 0
+	}
+	return
+}
+
+// SizePackedBools returns the number of bytes required for length packed
+// flags.
+func SizePackedBools(length int) (size int) {
+	size = length >> 3
+	if length&7 != 0 {
+		size++
+	}
+	return
+}
+
+// SkipPackedBools skips length packed flags.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrWrongFormat, if the unused bits of the
+// last byte are not zero.
+func SkipPackedBools(length int, bs []byte) (n int, err error) {
+	n = SizePackedBools(length)
+	if len(bs) < n {
+		return 0, mus.ErrTooSmallByteSlice
+	}
+	if rem := length & 7; rem != 0 && bs[n-1]>>rem != 0 {
+		return 0, com.ErrWrongFormat
+	}
+	return
+}
+
+func unmarshalPackedBoolSlice(bs []byte, lenSer mus.Serializer[int],
+	lenVl com.Validator[int], elemVl com.Validator[bool],
+) (v []bool, n int, err error) {
+	length, n, err := lenSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if len(bs)-n < SizePackedBools(length) {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	if lenVl != nil {
+		if err = lenVl.Validate(length); err != nil {
+			return
+		}
+	}
+	v = make([]bool, length)
+	n1, err := UnmarshalPackedBools(bs[n:], v)
+	n += n1
+	if err != nil {
+		return
+	}
+	if elemVl != nil {
+		for i := range v {
+			if err = elemVl.Validate(v[i]); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package ord
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// Extensible holds a value together with the trailing data that the inner
+// serializer did not recognize, for example fields appended by a newer
+// producer.
+type Extensible[T any] struct {
+	V       T
+	Unknown []byte
+}
+
+// NewExtensibleSer returns a new extensible value serializer with the given
+// inner serializer. A value is encoded as varint.PositiveInt length + inner
+// encoding + unknown data.
+//
+// On Unmarshal the inner serializer decodes the known prefix, the remaining
+// bytes are kept in Extensible.Unknown and re-emitted by Marshal, so values
+// from newer producers survive a round-trip.
+func NewExtensibleSer[T any](ser mus.Serializer[T]) extensibleSer[T] {
+	return extensibleSer[T]{ser}
+}
+
+type extensibleSer[T any] struct {
+	ser mus.Serializer[T]
+}
+
+// Marshal fills bs with an encoded extensible value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s extensibleSer[T]) Marshal(v Extensible[T], bs []byte) (n int) {
+	return MarshalLengthPrefixed(func(bs []byte) (n int) {
+		n = s.ser.Marshal(v.V, bs)
+		if len(bs[n:]) < len(v.Unknown) {
+			panic(mus.ErrTooSmallByteSlice)
+		}
+		return n + copy(bs[n:], v.Unknown)
+	}, bs)
+}
+
+// Unmarshal parses an encoded extensible value from bs.
+//
+// In addition to the extensible value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, a length
+// unmarshalling error, or an inner serializer unmarshalling error.
+func (s extensibleSer[T]) Unmarshal(bs []byte) (v Extensible[T], n int,
+	err error,
+) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	var (
+		data = bs[n : n+length]
+		n1   int
+	)
+	v.V, n1, err = s.ser.Unmarshal(data)
+	if err != nil {
+		n += n1
+		return
+	}
+	if n1 < length {
+		v.Unknown = make([]byte, length-n1)
+		copy(v.Unknown, data[n1:])
+	}
+	n += length
+	return
+}
+
+// Size returns the size of an encoded extensible value.
+func (s extensibleSer[T]) Size(v Extensible[T]) (size int) {
+	size = s.ser.Size(v.V) + len(v.Unknown)
+	return varint.PositiveInt.Size(size) + size
+}
+
+// Skip skips an encoded extensible value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a length unmarshalling
+// error.
+func (s extensibleSer[T]) Skip(bs []byte) (n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	n += length
+	return
+}
+
+func (s extensibleSer[T]) unmarshalLength(bs []byte) (length, n int,
+	err error,
+) {
+	length, n, err = varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > len(bs)-n {
+		err = mus.ErrTooSmallByteSlice
+	}
+	return
+}
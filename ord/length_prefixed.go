@@ -0,0 +1,27 @@
+package ord
+
+import (
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// MarshalLengthPrefixed fills bs with varint.PositiveInt length + data written
+// by the marshal function, which returns the number of bytes it used.
+//
+// The data is written first and shifted afterwards if the length takes more
+// than one byte, so marshal is called exactly once and no Size call is needed.
+// This keeps stateful serializers, such as sm or pm ones, consistent.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func MarshalLengthPrefixed(marshal func(bs []byte) (n int), bs []byte) (n int) {
+	l := marshal(bs[1:])
+	n = varint.PositiveInt.Size(l)
+	if n > 1 {
+		if len(bs) < n+l {
+			panic(mus.ErrTooSmallByteSlice)
+		}
+		copy(bs[n:n+l], bs[1:1+l])
+	}
+	varint.PositiveInt.Marshal(l, bs)
+	return n + l
+}
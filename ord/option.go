@@ -0,0 +1,138 @@
+package ord
+
+import (
+	"database/sql"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+// Option represents a value that may be absent, without the heap allocation
+// of a pointer.
+type Option[T any] struct {
+	Valid bool
+	V     T
+}
+
+// NewOptionSer returns a new Option serializer with the given base type
+// serializer. The encoding is the same as of NewPtrSer: com.Nil for an absent
+// value, com.NotNil + value otherwise.
+func NewOptionSer[T any](baseSer mus.Serializer[T]) optionSer[T] {
+	return optionSer[T]{baseSer}
+}
+
+type optionSer[T any] struct {
+	baseSer mus.Serializer[T]
+}
+
+// Marshal fills bs with an encoded Option value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s optionSer[T]) Marshal(v Option[T], bs []byte) (n int) {
+	return marshalOption(v.Valid, v.V, s.baseSer, bs)
+}
+
+// Unmarshal parses an encoded Option value from bs.
+//
+// In addition to the Option value and the number of used bytes, it can
+// return mus.ErrTooSmallByteSlice, com.ErrWrongFormat or a base type
+// unmarshalling error.
+func (s optionSer[T]) Unmarshal(bs []byte) (v Option[T], n int, err error) {
+	v.Valid, v.V, n, err = unmarshalOption(s.baseSer, bs)
+	return
+}
+
+// Size returns the size of an encoded Option value.
+func (s optionSer[T]) Size(v Option[T]) (size int) {
+	return sizeOption(v.Valid, v.V, s.baseSer)
+}
+
+// Skip skips an encoded Option value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrWrongFormat or a base type skipping error.
+func (s optionSer[T]) Skip(bs []byte) (n int, err error) {
+	return NewPtrSer(s.baseSer).Skip(bs)
+}
+
+// NewSQLNullSer returns a new sql.Null serializer with the given base type
+// serializer. The encoding is the same as of NewOptionSer and NewPtrSer.
+func NewSQLNullSer[T any](baseSer mus.Serializer[T]) sqlNullSer[T] {
+	return sqlNullSer[T]{baseSer}
+}
+
+type sqlNullSer[T any] struct {
+	baseSer mus.Serializer[T]
+}
+
+// Marshal fills bs with an encoded sql.Null value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s sqlNullSer[T]) Marshal(v sql.Null[T], bs []byte) (n int) {
+	return marshalOption(v.Valid, v.V, s.baseSer, bs)
+}
+
+// Unmarshal parses an encoded sql.Null value from bs.
+//
+// In addition to the sql.Null value and the number of used bytes, it can
+// return mus.ErrTooSmallByteSlice, com.ErrWrongFormat or a base type
+// unmarshalling error.
+func (s sqlNullSer[T]) Unmarshal(bs []byte) (v sql.Null[T], n int, err error) {
+	v.Valid, v.V, n, err = unmarshalOption(s.baseSer, bs)
+	return
+}
+
+// Size returns the size of an encoded sql.Null value.
+func (s sqlNullSer[T]) Size(v sql.Null[T]) (size int) {
+	return sizeOption(v.Valid, v.V, s.baseSer)
+}
+
+// Skip skips an encoded sql.Null value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrWrongFormat or a base type skipping error.
+func (s sqlNullSer[T]) Skip(bs []byte) (n int, err error) {
+	return NewPtrSer(s.baseSer).Skip(bs)
+}
+
+func marshalOption[T any](valid bool, v T, baseSer mus.Serializer[T],
+	bs []byte,
+) (n int) {
+	if !valid {
+		bs[0] = byte(com.Nil)
+		return 1
+	}
+	bs[0] = byte(com.NotNil)
+	return 1 + baseSer.Marshal(v, bs[1:])
+}
+
+func unmarshalOption[T any](baseSer mus.Serializer[T], bs []byte) (
+	valid bool, v T, n int, err error,
+) {
+	if len(bs) < 1 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	if bs[0] == byte(com.Nil) {
+		n = 1
+		return
+	}
+	if bs[0] != byte(com.NotNil) {
+		err = com.ErrWrongFormat
+		return
+	}
+	v, n, err = baseSer.Unmarshal(bs[1:])
+	n++
+	if err != nil {
+		return
+	}
+	valid = true
+	return
+}
+
+func sizeOption[T any](valid bool, v T, baseSer mus.Serializer[T]) int {
+	if valid {
+		return 1 + baseSer.Size(v)
+	}
+	return 1
+}
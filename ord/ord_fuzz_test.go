@@ -191,3 +191,113 @@ func FuzzOrd_PtrUnmarshal(f *testing.F) {
 		ser.Skip(bs)
 	})
 }
+
+// packed bool slice -----------------------------------------------------------
+
+func FuzzOrd_PackedBoolSlice(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		if len(bs) > maxLen {
+			bs = bs[:maxLen]
+		}
+		v := make([]bool, len(bs))
+		for i, b := range bs {
+			v[i] = b&1 == 1
+		}
+		test.Test([][]bool{v}, PackedBoolSlice, t)
+		test.TestSkip([][]bool{v}, PackedBoolSlice, t)
+	})
+}
+
+func FuzzOrd_PackedBoolSliceUnmarshal(f *testing.F) {
+	// We use Valid serializer to avoid OOM during fuzzing.
+	ser := NewValidPackedBoolSliceSer(slopts.WithLenValidator[bool](
+		com.ValidatorFn[int](func(v int) error {
+			if v > maxLen {
+				return errors.New("too large length")
+			}
+			return nil
+		}),
+	))
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
+
+// rle slice -------------------------------------------------------------------
+
+func FuzzOrd_RLESlice(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		if len(bs) > maxLen {
+			bs = bs[:maxLen]
+		}
+		v := make([]int, len(bs))
+		for i, b := range bs {
+			v[i] = int(b % 4)
+		}
+		ser := NewRLESliceSer(varint.Int)
+		test.Test([][]int{v}, ser, t)
+		test.TestSkip([][]int{v}, ser, t)
+	})
+}
+
+func FuzzOrd_RLESliceUnmarshal(f *testing.F) {
+	// We use Valid serializer to avoid OOM during fuzzing.
+	ser := NewValidRLESliceSer(varint.Int, slopts.WithLenValidator[int](
+		com.ValidatorFn[int](func(v int) error {
+			if v > maxLen {
+				return errors.New("too large length")
+			}
+			return nil
+		}),
+	))
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
+
+// extensible ------------------------------------------------------------------
+
+func FuzzOrd_Extensible(f *testing.F) {
+	f.Fuzz(func(t *testing.T, str string, unknown []byte) {
+		if len(unknown) == 0 {
+			unknown = nil
+		}
+		var (
+			v   = Extensible[string]{V: str, Unknown: unknown}
+			ser = NewExtensibleSer[string](String)
+		)
+		test.Test([]Extensible[string]{v}, ser, t)
+		test.TestSkip([]Extensible[string]{v}, ser, t)
+	})
+}
+
+func FuzzOrd_ExtensibleUnmarshal(f *testing.F) {
+	ser := NewExtensibleSer[string](String)
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
+
+// option ----------------------------------------------------------------------
+
+func FuzzOrd_Option(f *testing.F) {
+	ser := NewOptionSer[int](varint.Int)
+	f.Fuzz(func(t *testing.T, valid bool, v int) {
+		if !valid {
+			v = 0
+		}
+		test.Test([]Option[int]{{Valid: valid, V: v}}, ser, t)
+		test.TestSkip([]Option[int]{{Valid: valid, V: v}}, ser, t)
+	})
+}
+
+func FuzzOrd_OptionUnmarshal(f *testing.F) {
+	ser := NewOptionSer[int](varint.Int)
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
@@ -2,6 +2,7 @@ package ord
 
 import (
 	"bytes"
+	"database/sql"
 	"errors"
 	"reflect"
 	"testing"
@@ -14,9 +15,12 @@ import (
 	mapopts "github.com/mus-format/mus-go/options/map"
 	slopts "github.com/mus-format/mus-go/options/slice"
 	stropts "github.com/mus-format/mus-go/options/string"
+	"github.com/mus-format/mus-go/pm"
 	"github.com/mus-format/mus-go/test"
 	mock "github.com/mus-format/mus-go/test/mock"
 	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
 	"github.com/ymz-ncnk/mok"
 )
 
@@ -1370,6 +1374,610 @@ func TestOrd_Map(t *testing.T) {
 		})
 }
 
+func TestOrd_PackedBoolSlice(t *testing.T) {
+	t.Run("PackedBoolSlice serializer should succeed",
+		func(t *testing.T) {
+			var (
+				sls = [][]bool{
+					{},
+					{true},
+					{false, true, true, false, true, false, false, true},
+					{true, false, true, true, false, false, true, false, true},
+				}
+				ser = PackedBoolSlice
+			)
+			test.Test(sls, ser, t)
+			test.TestSkip(sls, ser, t)
+		})
+
+	t.Run("Marshal should pack 8 flags per byte", func(t *testing.T) {
+		var (
+			v      = []bool{true, false, true, true, false, false, true, false, true}
+			wantBs = []byte{9, 0b01001101, 0b00000001}
+			bs     = make([]byte, PackedBoolSlice.Size(v))
+		)
+		PackedBoolSlice.Marshal(v, bs)
+		if !bytes.Equal(bs, wantBs) {
+			t.Errorf("unexpected bs, want %v actual %v", wantBs, bs)
+		}
+	})
+
+	t.Run("We should be able to set a length serializer", func(t *testing.T) {
+		var (
+			v      = []bool{true, false, true}
+			lenSer = mock.NewSerializer[int]().
+				RegisterMarshal(func(v int, bs []byte) (n int) {
+					bs[0] = byte(v)
+					return 1
+				}).
+				RegisterSize(func(v int) (size int) { return 1 }).
+				RegisterUnmarshal(func(bs []byte) (v int, n int, err error) {
+					return int(bs[0]), 1, nil
+				})
+			ser = NewPackedBoolSliceSer(slopts.WithLenSer[bool](lenSer))
+		)
+		test.Test([][]bool{v}, ser, t)
+	})
+
+	t.Run("Unmarshal should return ErrNegativeLength if meets a negative length",
+		func(t *testing.T) {
+			var (
+				wantN, bs = NegativeLengthBs()
+				want      = test.UnmarshalResult[[]bool]{
+					N:   wantN,
+					Err: com.ErrNegativeLength,
+				}
+			)
+			test.TestUnmarshalOnly(bs, PackedBoolSlice, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{9, 1}
+				want = test.UnmarshalResult[[]bool]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, PackedBoolSlice, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if unused bits are not zero",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{3, 0b00001000}
+				want = test.UnmarshalResult[[]bool]{
+					V:   []bool{false, false, false},
+					N:   1,
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly(bs, PackedBoolSlice, want, nil, t)
+		})
+
+	t.Run("Skip should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{9, 1}
+				want = test.SkipResult{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestSkipOnly(bs, PackedBoolSlice, want, nil, t)
+		})
+
+	t.Run("Skip should return ErrWrongFormat if unused bits are not zero",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{3, 0b00001000}
+				want = test.SkipResult{
+					N:   1,
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestSkipOnly(bs, PackedBoolSlice, want, nil, t)
+		})
+
+	t.Run("Valid PackedBoolSlice serializer should succeed",
+		func(t *testing.T) {
+			var (
+				sls = [][]bool{{}, {true, false, true}}
+				ser = NewValidPackedBoolSliceSer()
+			)
+			test.Test(sls, ser, t)
+			test.TestSkip(sls, ser, t)
+		})
+
+	t.Run("If lenVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("lenVl error")
+				bs      = []byte{3, 0b00000101}
+				lenVl   = cmock.NewValidator[int]().RegisterValidate(
+					func(v int) (err error) { return wantErr },
+				)
+				ser  = NewValidPackedBoolSliceSer(slopts.WithLenValidator[bool](lenVl))
+				want = test.UnmarshalResult[[]bool]{
+					N:   1,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{lenVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+
+	t.Run("If elemVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("elemVl error")
+				bs      = []byte{3, 0b00000101}
+				elemVl  = cmock.NewValidator[bool]().RegisterValidate(
+					func(v bool) (err error) { return wantErr },
+				)
+				ser  = NewValidPackedBoolSliceSer(slopts.WithElemValidator(elemVl))
+				want = test.UnmarshalResult[[]bool]{
+					V:   []bool{true, false, true},
+					N:   2,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{elemVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+}
+
+func TestOrd_Bitset(t *testing.T) {
+	t.Run("Bitset serializer should succeed", func(t *testing.T) {
+		var (
+			bitsets = [][]uint64{
+				{0, 0},
+				{1<<63 | 1, 0x3F},
+				{0xFFFFFFFFFFFFFFFF, 0x3FFFFFFFFFFFFFFF},
+			}
+			ser = NewBitsetSer(126)
+		)
+		test.Test(bitsets, ser, t)
+		test.TestSkip(bitsets, ser, t)
+	})
+
+	t.Run("Marshal should ignore bits beyond the bit length and treat missing words as zeros",
+		func(t *testing.T) {
+			var (
+				ser    = NewBitsetSer(12)
+				wantBs = []byte{0xFF, 0x0F}
+				bs     = make([]byte, ser.Size(nil))
+			)
+			ser.Marshal([]uint64{0xFFFF}, bs)
+			if !bytes.Equal(bs, wantBs) {
+				t.Errorf("unexpected bs, want %v actual %v", wantBs, bs)
+			}
+			bs = make([]byte, ser.Size(nil))
+			ser.Marshal(nil, bs)
+			if !bytes.Equal(bs, []byte{0, 0}) {
+				t.Errorf("unexpected bs, want %v actual %v", []byte{0, 0}, bs)
+			}
+		})
+
+	t.Run("NewBitsetSer should panic with ErrNegativeLength if bitLen is negative",
+		func(t *testing.T) {
+			wantErr := com.ErrNegativeLength
+			defer func() {
+				if r := recover(); r != wantErr {
+					t.Errorf("unexpected error, want '%v' actual '%v'", wantErr, r)
+				}
+			}()
+			NewBitsetSer(-1)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1}
+				want = test.UnmarshalResult[[]uint64]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewBitsetSer(12), want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if unused bits are not zero",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1, 0x10}
+				want = test.UnmarshalResult[[]uint64]{
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewBitsetSer(12), want, nil, t)
+		})
+
+	t.Run("Skip should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1}
+				want = test.SkipResult{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestSkipOnly(bs, NewBitsetSer(12), want, nil, t)
+		})
+}
+
+func TestOrd_RLESlice(t *testing.T) {
+	t.Run("RLE slice serializer should succeed", func(t *testing.T) {
+		var (
+			sls = [][]int{
+				{},
+				{1},
+				{1, 1, 1, 1, 2, 2, 3, 1, 1},
+				{-1, -2, -3},
+			}
+			ser = NewRLESliceSer(varint.Int)
+		)
+		test.Test(sls, ser, t)
+		test.TestSkip(sls, ser, t)
+	})
+
+	t.Run("Marshal should encode runs", func(t *testing.T) {
+		var (
+			v      = []string{"a", "a", "a", "b", "a", "a"}
+			wantBs = []byte{6, 3, 1, 'a', 1, 1, 'b', 2, 1, 'a'}
+			ser    = NewRLESliceSer(String)
+			bs     = make([]byte, ser.Size(v))
+		)
+		ser.Marshal(v, bs)
+		if !bytes.Equal(bs, wantBs) {
+			t.Errorf("unexpected bs, want %v actual %v", wantBs, bs)
+		}
+	})
+
+	t.Run("Unmarshal should return ErrNegativeLength if meets a negative length",
+		func(t *testing.T) {
+			var (
+				wantN, bs = NegativeLengthBs()
+				want      = test.UnmarshalResult[[]int]{
+					N:   wantN,
+					Err: com.ErrNegativeLength,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewRLESliceSer(varint.Int), want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if a run count exceeds the length",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{3, 2, 2, 2, 4}
+				want = test.UnmarshalResult[[]int]{
+					V:   []int{1, 1, 0},
+					N:   4,
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewRLESliceSer(varint.Int), want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if a run count is zero",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1, 0, 2}
+				want = test.UnmarshalResult[[]int]{
+					V:   []int{0},
+					N:   2,
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewRLESliceSer(varint.Int), want, nil, t)
+		})
+
+	t.Run("If elemSer fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("elemSer error")
+				elemSer = mock.NewSerializer[int]().RegisterUnmarshal(
+					func(bs []byte) (v int, n int, err error) {
+						return 0, 1, wantErr
+					},
+				)
+				bs   = []byte{2, 2, 1}
+				want = test.UnmarshalResult[[]int]{
+					V:   []int{0, 0},
+					N:   3,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{elemSer.Mock}
+			)
+			test.TestUnmarshalOnly(bs, NewRLESliceSer(elemSer), want, mocks, t)
+		})
+
+	t.Run("Skip should not unmarshal elements", func(t *testing.T) {
+		var (
+			elemSer = mock.NewSerializer[int]().RegisterSkipN(2,
+				func(bs []byte) (n int, err error) { return 1, nil },
+			)
+			bs   = []byte{5, 3, 1, 2, 1}
+			want = test.SkipResult{
+				N:   5,
+				Err: nil,
+			}
+			mocks = []*mok.Mock{elemSer.Mock}
+		)
+		test.TestSkipOnly(bs, NewRLESliceSer(elemSer), want, mocks, t)
+	})
+
+	t.Run("Skip should return ErrWrongFormat if a run count exceeds the length",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{3, 4, 2}
+				want = test.SkipResult{
+					N:   2,
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestSkipOnly(bs, NewRLESliceSer(varint.Int), want, nil, t)
+		})
+
+	t.Run("Valid RLE slice serializer should succeed", func(t *testing.T) {
+		var (
+			sls = [][]int{{}, {1, 1, 1, 2}}
+			ser = NewValidRLESliceSer(varint.Int)
+		)
+		test.Test(sls, ser, t)
+		test.TestSkip(sls, ser, t)
+	})
+
+	t.Run("If lenVl returns an error, valid Unmarshal should return it before allocation",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("lenVl error")
+				lenVl   = cmock.NewValidator[int]().RegisterValidate(
+					func(v int) (err error) {
+						asserterror.Equal(t, v, 1<<40)
+						return wantErr
+					},
+				)
+				ser = NewValidRLESliceSer(varint.Int,
+					slopts.WithLenValidator[int](lenVl))
+				want = test.UnmarshalResult[[]int]{
+					N:   6,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{lenVl.Mock}
+			)
+			test.TestUnmarshalOnly([]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x20, 1, 2},
+				ser, want, mocks, t)
+		})
+
+	t.Run("If elemVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("elemVl error")
+				elemVl  = cmock.NewValidator[int]().RegisterValidate(
+					func(v int) (err error) {
+						asserterror.Equal(t, v, 1)
+						return wantErr
+					},
+				)
+				ser = NewValidRLESliceSer(varint.Int,
+					slopts.WithElemValidator(elemVl))
+				bs   = []byte{2, 2, 2}
+				want = test.UnmarshalResult[[]int]{
+					V:   []int{0, 0},
+					N:   3,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{elemVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+}
+
+func TestOrd_Extensible(t *testing.T) {
+	t.Run("Extensible serializer should succeed", func(t *testing.T) {
+		var (
+			vs = []Extensible[string]{
+				{V: ""},
+				{V: "abc"},
+				{V: "abc", Unknown: []byte{10, 1, 2}},
+			}
+			ser = NewExtensibleSer[string](String)
+		)
+		test.Test(vs, ser, t)
+		test.TestSkip(vs, ser, t)
+	})
+
+	t.Run("Unmarshal should keep trailing data as unknown and Marshal should re-emit it",
+		func(t *testing.T) {
+			var (
+				// Encoded by a newer producer as string + int.
+				bs   = []byte{5, 3, 'a', 'b', 'c', 10}
+				want = Extensible[string]{V: "abc", Unknown: []byte{10}}
+				ser  = NewExtensibleSer[string](String)
+			)
+			v, n, err := ser.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			asserterror.Equal(t, n, len(bs))
+			asserterror.EqualDeep(t, v, want)
+
+			abs := make([]byte, ser.Size(v))
+			ser.Marshal(v, abs)
+			asserterror.EqualDeep(t, abs, bs)
+		})
+
+	t.Run("Extensible serializer should support stateful inner serializers",
+		func(t *testing.T) {
+			var (
+				str       = "hello"
+				ptrMap    = com.NewPtrMap()
+				revPtrMap = com.NewReversePtrMap()
+				ser       = pm.Wrap(ptrMap, revPtrMap,
+					NewSliceSer[Extensible[*string]](NewExtensibleSer[*string](
+						pm.NewPtrSer(ptrMap, revPtrMap, String))))
+				v = []Extensible[*string]{
+					{V: &str, Unknown: []byte{10}},
+					{V: &str},
+				}
+			)
+			test.Test([][]Extensible[*string]{v}, ser, t)
+			test.TestSkip([][]Extensible[*string]{v}, ser, t)
+
+			bs := make([]byte, ser.Size(v))
+			ser.Marshal(v, bs)
+			av, _, err := ser.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			asserterror.Equal(t, av[0].V == av[1].V, true, "pointers are not equal")
+		})
+
+	t.Run("Marshal should shift the inner encoding if the length takes several bytes",
+		func(t *testing.T) {
+			ser := NewExtensibleSer[string](String)
+			test.Test([]Extensible[string]{
+				{V: string(bytes.Repeat([]byte("a"), 200)), Unknown: []byte{1}},
+			}, ser, t)
+		})
+
+	t.Run("Unknown data should not reference bs", func(t *testing.T) {
+		var (
+			bs  = []byte{2, 0, 10}
+			ser = NewExtensibleSer[string](String)
+		)
+		v, _, err := ser.Unmarshal(bs)
+		assertfatal.EqualError(t, err, nil)
+		bs[2] = 11
+		asserterror.EqualDeep(t, v.Unknown, []byte{10})
+	})
+
+	t.Run("Unmarshal and Skip should return mus.ErrTooSmallByteSlice if the length exceeds bs",
+		func(t *testing.T) {
+			var (
+				bs  = []byte{5, 3, 'a'}
+				ser = NewExtensibleSer[string](String)
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[Extensible[string]]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 1, Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrNegativeLength if the length is negative",
+		func(t *testing.T) {
+			var (
+				n, bs = NegativeLengthBs()
+				ser   = NewExtensibleSer[string](String)
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[Extensible[string]]{
+					N:   n,
+					Err: com.ErrNegativeLength,
+				}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: n, Err: com.ErrNegativeLength}, nil, t)
+		})
+
+	t.Run("If the inner serializer fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				bs  = []byte{2, 3, 'a', 'b', 'c'}
+				ser = NewExtensibleSer[string](String)
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[Extensible[string]]{
+					N:   2,
+					Err: mus.ErrTooSmallByteSlice,
+				}, nil, t)
+		})
+}
+
+func TestOrd_Option(t *testing.T) {
+	t.Run("Option serializer should succeed", func(t *testing.T) {
+		var (
+			vs  = []Option[int]{{}, {Valid: true}, {Valid: true, V: -10}}
+			ser = NewOptionSer[int](varint.Int)
+		)
+		test.Test(vs, ser, t)
+		test.TestSkip(vs, ser, t)
+	})
+
+	t.Run("sql.Null serializer should succeed", func(t *testing.T) {
+		var (
+			vs = []sql.Null[string]{
+				{},
+				{Valid: true},
+				{Valid: true, V: "abc"},
+			}
+			ser = NewSQLNullSer[string](String)
+		)
+		test.Test(vs, ser, t)
+		test.TestSkip(vs, ser, t)
+	})
+
+	t.Run("Option encoding should be the same as of pointer", func(t *testing.T) {
+		var (
+			num    = 5
+			ptrSer = NewPtrSer[int](varint.Int)
+			optSer = NewOptionSer[int](varint.Int)
+			nulSer = NewSQLNullSer[int](varint.Int)
+		)
+		for _, c := range []struct {
+			ptr *int
+			opt Option[int]
+			nul sql.Null[int]
+		}{
+			{nil, Option[int]{}, sql.Null[int]{}},
+			{
+				&num,
+				Option[int]{Valid: true, V: num},
+				sql.Null[int]{V: num, Valid: true},
+			},
+		} {
+			var (
+				want  = make([]byte, ptrSer.Size(c.ptr))
+				optBs = make([]byte, optSer.Size(c.opt))
+				nulBs = make([]byte, nulSer.Size(c.nul))
+			)
+			ptrSer.Marshal(c.ptr, want)
+			optSer.Marshal(c.opt, optBs)
+			nulSer.Marshal(c.nul, nulBs)
+			asserterror.EqualDeep(t, optBs, want)
+			asserterror.EqualDeep(t, nulBs, want)
+		}
+	})
+
+	t.Run("Unmarshal and Skip should return mus.ErrTooSmallByteSlice if bs is empty",
+		func(t *testing.T) {
+			ser := NewOptionSer[int](varint.Int)
+			test.TestUnmarshalOnly([]byte{}, ser,
+				test.UnmarshalResult[Option[int]]{Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+			test.TestSkipOnly([]byte{}, ser,
+				test.SkipResult{Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrWrongFormat if the marker is unknown",
+		func(t *testing.T) {
+			ser := NewSQLNullSer[int](varint.Int)
+			test.TestUnmarshalOnly([]byte{2}, ser,
+				test.UnmarshalResult[sql.Null[int]]{Err: com.ErrWrongFormat},
+				nil, t)
+			test.TestSkipOnly([]byte{2}, ser,
+				test.SkipResult{Err: com.ErrWrongFormat}, nil, t)
+		})
+
+	t.Run("If base type unmarshalling fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			ser := NewOptionSer[string](String)
+			test.TestUnmarshalOnly([]byte{byte(com.NotNil), 3, 'a'}, ser,
+				test.UnmarshalResult[Option[string]]{
+					N:   2,
+					Err: mus.ErrTooSmallByteSlice,
+				}, nil, t)
+		})
+}
+
 func NegativeLengthBs() (n int, bs []byte) {
 	n = varint.PositiveInt.Size(-1)
 	bs = make([]byte, n)
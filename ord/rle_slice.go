@@ -0,0 +1,209 @@
+package ord
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewRLESliceSer returns a new run-length encoding slice serializer with the
+// given element serializer. A slice is encoded as length + a sequence of
+// (count, value) runs, where count is encoded with varint.PositiveInt.
+//
+// Since a short input may declare a large length, use NewValidRLESliceSer with
+// a length validator to unmarshal untrusted data.
+func NewRLESliceSer[T comparable](elemSer mus.Serializer[T],
+	opts ...slopts.SetOption[T],
+) rleSliceSer[T] {
+	o := slopts.Options[T]{}
+	slopts.Apply(opts, &o)
+
+	return newRLESliceSer(elemSer, o)
+}
+
+// NewValidRLESliceSer returns a new valid run-length encoding slice
+// serializer. The length validator is applied to the total decoded length
+// before any allocation, the element validator - once per run.
+func NewValidRLESliceSer[T comparable](elemSer mus.Serializer[T],
+	opts ...slopts.SetOption[T],
+) validRLESliceSer[T] {
+	o := slopts.Options[T]{}
+	slopts.Apply(opts, &o)
+
+	return validRLESliceSer[T]{
+		rleSliceSer: newRLESliceSer(elemSer, o),
+		lenVl:       o.LenVl,
+		elemVl:      o.ElemVl,
+	}
+}
+
+func newRLESliceSer[T comparable](elemSer mus.Serializer[T],
+	o slopts.Options[T],
+) rleSliceSer[T] {
+	var lenSer mus.Serializer[int] = varint.PositiveInt
+	if o.LenSer != nil {
+		lenSer = o.LenSer
+	}
+	return rleSliceSer[T]{
+		lenSer:  lenSer,
+		elemSer: elemSer,
+	}
+}
+
+type rleSliceSer[T comparable] struct {
+	lenSer  mus.Serializer[int]
+	elemSer mus.Serializer[T]
+}
+
+// Marshal fills bs with an encoded slice value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s rleSliceSer[T]) Marshal(v []T, bs []byte) (n int) {
+	n = s.lenSer.Marshal(len(v), bs)
+	for i := 0; i < len(v); {
+		count := runLength(v[i:])
+		n += varint.PositiveInt.Marshal(count, bs[n:])
+		n += s.elemSer.Marshal(v[i], bs[n:])
+		i += count
+	}
+	return
+}
+
+// Unmarshal parses an encoded slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return com.ErrNegativeLength, com.ErrWrongFormat, if a run count is not
+// positive or exceeds the length, or a length/count/element unmarshalling
+// error.
+func (s rleSliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	return s.unmarshal(bs, nil, nil)
+}
+
+// Size returns the size of an encoded slice value.
+func (s rleSliceSer[T]) Size(v []T) (size int) {
+	size = s.lenSer.Size(len(v))
+	for i := 0; i < len(v); {
+		count := runLength(v[i:])
+		size += varint.PositiveInt.Size(count) + s.elemSer.Size(v[i])
+		i += count
+	}
+	return
+}
+
+// Skip skips an encoded slice value without unmarshalling its elements.
+//
+// In addition to the number of skipped bytes, it may also return
+// com.ErrNegativeLength, com.ErrWrongFormat, a length/count unmarshalling
+// error, or an element skipping error.
+func (s rleSliceSer[T]) Skip(bs []byte) (n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	var count, n1 int
+	for length > 0 {
+		count, n1, err = unmarshalRunCount(length, bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		n1, err = s.elemSer.Skip(bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		length -= count
+	}
+	return
+}
+
+func (s rleSliceSer[T]) unmarshal(bs []byte, lenVl com.Validator[int],
+	elemVl com.Validator[T],
+) (v []T, n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	if lenVl != nil {
+		if err = lenVl.Validate(length); err != nil {
+			return
+		}
+	}
+	var (
+		count, n1 int
+		e         T
+	)
+	v = make([]T, length)
+	for i := 0; i < length; i += count {
+		count, n1, err = unmarshalRunCount(length-i, bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		e, n1, err = s.elemSer.Unmarshal(bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		if elemVl != nil {
+			if err = elemVl.Validate(e); err != nil {
+				return
+			}
+		}
+		for j := i; j < i+count; j++ {
+			v[j] = e
+		}
+	}
+	return
+}
+
+func (s rleSliceSer[T]) unmarshalLength(bs []byte) (length, n int, err error) {
+	length, n, err = s.lenSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+	}
+	return
+}
+
+// valid -----------------------------------------------------------------------
+
+type validRLESliceSer[T comparable] struct {
+	rleSliceSer[T]
+	lenVl  com.Validator[int]
+	elemVl com.Validator[T]
+}
+
+// Unmarshal parses an encoded slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return com.ErrNegativeLength, com.ErrWrongFormat, if a run count is not
+// positive or exceeds the length, a length/count/element unmarshalling error,
+// or a length/element validation error.
+func (s validRLESliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	return s.unmarshal(bs, s.lenVl, s.elemVl)
+}
+
+// -----------------------------------------------------------------------------
+
+func runLength[T comparable](v []T) (count int) {
+	count = 1
+	for count < len(v) && v[count] == v[0] {
+		count++
+	}
+	return
+}
+
+func unmarshalRunCount(remaining int, bs []byte) (count, n int, err error) {
+	count, n, err = varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if count <= 0 || count > remaining {
+		err = com.ErrWrongFormat
+	}
+	return
+}
@@ -0,0 +1,3 @@
+// Package presence provides a struct serializer for sparse structs, which
+// encodes a presence bitmap followed by the present fields only.
+package presence
@@ -0,0 +1,27 @@
+package presence
+
+import (
+	"testing"
+
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzPresence_StructSer(f *testing.F) {
+	ser := configSer()
+	f.Add("", 0, false, 0)
+	f.Add("svc", 8080, true, -1)
+	f.Fuzz(func(t *testing.T, name string, port int, enabled bool, e int) {
+		v := config{Name: name, Port: port, Enabled: enabled, E: e}
+		test.Test([]config{v}, ser, t)
+		test.TestSkip([]config{v}, ser, t)
+	})
+}
+
+func FuzzPresence_StructSerUnmarshal(f *testing.F) {
+	ser := configSer()
+	f.Add([]byte{0b00000010, 0b00000001, 0xa0, 0x7e, 2})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
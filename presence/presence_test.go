@@ -0,0 +1,117 @@
+package presence
+
+import (
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+)
+
+type config struct {
+	Name    string
+	Port    int
+	Enabled bool
+	Tags    []string
+	A, B, C int
+	D, E    int
+}
+
+func configSer() structSer[config] {
+	return NewStructSer(
+		NewField(ord.String, func(v *config) *string { return &v.Name }),
+		NewField(varint.Int, func(v *config) *int { return &v.Port }),
+		NewField(ord.Bool, func(v *config) *bool { return &v.Enabled }),
+		// We use Valid serializer to avoid OOM during fuzzing.
+		NewFieldFn(ord.NewValidSliceSer(ord.String,
+			slopts.WithLenValidator[string](com.ValidatorFn[int](
+				func(l int) (err error) {
+					if l > 100 {
+						err = com.ErrTooLargeLength
+					}
+					return
+				}))),
+			func(v *config) *[]string { return &v.Tags },
+			func(f []string) bool { return len(f) > 0 }),
+		NewField(varint.Int, func(v *config) *int { return &v.A }),
+		NewField(varint.Int, func(v *config) *int { return &v.B }),
+		NewField(varint.Int, func(v *config) *int { return &v.C }),
+		NewField(varint.Int, func(v *config) *int { return &v.D }),
+		NewField(varint.Int, func(v *config) *int { return &v.E }),
+	)
+}
+
+func TestPresence_StructSer(t *testing.T) {
+	t.Run("Marshal should write the bitmap and present fields only",
+		func(t *testing.T) {
+			var (
+				ser    = configSer()
+				v      = config{Port: 8080, E: 1}
+				wantBS = []byte{0b00000010, 0b00000001, 0xa0, 0x7e, 2}
+				bs     = make([]byte, ser.Size(v))
+				n      = ser.Marshal(v, bs)
+			)
+			asserterror.Equal(t, n, len(wantBS))
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Struct serializer should work correctly", func(t *testing.T) {
+		ser := configSer()
+		test.Test([]config{
+			{},
+			{Name: "svc", Port: 8080},
+			{Enabled: true, Tags: []string{"a", "b"}, E: -1},
+			{"svc", 1, true, []string{"a"}, 1, 2, 3, 4, 5},
+		}, ser, t)
+		test.TestSkip([]config{
+			{},
+			{Enabled: true, Tags: []string{"a", "b"}, E: -1},
+		}, ser, t)
+	})
+
+	t.Run("Unmarshal and Skip should return mus.ErrTooSmallByteSlice if bs is shorter than the bitmap",
+		func(t *testing.T) {
+			ser := configSer()
+			test.TestUnmarshalOnly([]byte{0}, ser,
+				test.UnmarshalResult[config]{Err: mus.ErrTooSmallByteSlice}, nil, t)
+			test.TestSkipOnly([]byte{0}, ser,
+				test.SkipResult{Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrWrongFormat if the bitmap has bits set beyond the number of fields",
+		func(t *testing.T) {
+			var (
+				ser = configSer()
+				bs  = []byte{0, 0b00000010}
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[config]{Err: com.ErrWrongFormat}, nil, t)
+			test.TestSkipOnly(bs, ser, test.SkipResult{Err: com.ErrWrongFormat},
+				nil, t)
+		})
+
+	t.Run("If field unmarshalling fails with an error, Unmarshal and Skip should return it",
+		func(t *testing.T) {
+			var (
+				ser = configSer()
+				bs  = []byte{0b00000001, 0, 3, 'a'}
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[config]{N: 3, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 3, Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Marshal should panic with mus.ErrTooSmallByteSlice if bs is shorter than the bitmap",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), mus.ErrTooSmallByteSlice)
+			}()
+			configSer().Marshal(config{}, make([]byte, 1))
+		})
+}
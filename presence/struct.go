@@ -0,0 +1,97 @@
+package presence
+
+import (
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/ord"
+)
+
+// NewStructSer returns a new struct serializer with the given fields. A
+// struct is encoded as a presence bitmap of (len(fields) + 7) / 8 bytes (bit i
+// corresponds to fields[i]) followed by the present fields in order. Absent
+// fields are left zero after unmarshalling.
+//
+// Fields are not length-prefixed, so unknown ones can't be skipped, and the
+// reader must use the same list of fields as the writer. A value with a bit
+// set beyond the reader's fields is rejected with com.ErrWrongFormat. For
+// schema evolution, use the record package.
+func NewStructSer[T any](fields ...Field[T]) structSer[T] {
+	return structSer[T]{fields}
+}
+
+type structSer[T any] struct {
+	fields []Field[T]
+}
+
+// Marshal fills bs with an encoded struct value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s structSer[T]) Marshal(v T, bs []byte) (n int) {
+	n = ord.SizePackedBools(len(s.fields))
+	if len(bs) < n {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	clear(bs[:n])
+	for i := range s.fields {
+		if s.fields[i].IsPresent(&v) {
+			bs[i>>3] |= 1 << (i & 7)
+			n += s.fields[i].Marshal(&v, bs[n:])
+		}
+	}
+	return
+}
+
+// Unmarshal parses an encoded struct value from bs.
+//
+// In addition to the struct value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrWrongFormat, if the bitmap has bits
+// set beyond the number of fields, or a field unmarshalling error.
+func (s structSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	n, err = ord.SkipPackedBools(len(s.fields), bs)
+	if err != nil {
+		return
+	}
+	var n1 int
+	for i := range s.fields {
+		if bs[i>>3]&(1<<(i&7)) != 0 {
+			n1, err = s.fields[i].Unmarshal(&v, bs[n:])
+			n += n1
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// Size returns the size of an encoded struct value.
+func (s structSer[T]) Size(v T) (size int) {
+	size = ord.SizePackedBools(len(s.fields))
+	for i := range s.fields {
+		if s.fields[i].IsPresent(&v) {
+			size += s.fields[i].Size(&v)
+		}
+	}
+	return
+}
+
+// Skip skips an encoded struct value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrWrongFormat, or a field skipping error.
+func (s structSer[T]) Skip(bs []byte) (n int, err error) {
+	n, err = ord.SkipPackedBools(len(s.fields), bs)
+	if err != nil {
+		return
+	}
+	var n1 int
+	for i := range s.fields {
+		if bs[i>>3]&(1<<(i&7)) != 0 {
+			n1, err = s.fields[i].Skip(bs[n:])
+			n += n1
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,267 @@
+package raw
+
+import (
+	"math"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+// BE contains big-endian (network byte order) counterparts of the numeric
+// serializers, with the same Size and Skip semantics.
+var BE = BigEndian{
+	Uint64:  beInteger64Ser[uint64]{},
+	Uint32:  beInteger32Ser[uint32]{},
+	Uint16:  beInteger16Ser[uint16]{},
+	Uint8:   Uint8,
+	Int64:   beInteger64Ser[int64]{},
+	Int32:   beInteger32Ser[int32]{},
+	Int16:   beInteger16Ser[int16]{},
+	Int8:    Int8,
+	Float64: beFloat64Ser{},
+	Float32: beFloat32Ser{},
+}
+
+// BigEndian is a set of big-endian numeric serializers. Single-byte values
+// have no byte order, so Uint8 and Int8 are the same as the package ones.
+type BigEndian struct {
+	Uint64  beInteger64Ser[uint64]
+	Uint32  beInteger32Ser[uint32]
+	Uint16  beInteger16Ser[uint16]
+	Uint8   uint8Ser
+	Int64   beInteger64Ser[int64]
+	Int32   beInteger32Ser[int32]
+	Int16   beInteger16Ser[int16]
+	Int8    int8Ser
+	Float64 beFloat64Ser
+	Float32 beFloat32Ser
+}
+
+// integer64 -------------------------------------------------------------------
+
+type beInteger64Ser[T com.Integer64] struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) 64-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beInteger64Ser[T]) Marshal(v T, bs []byte) (n int) {
+	return marshalInteger64BE(v, bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) 64-bit integer value from bs.
+//
+// In addition to the integer value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beInteger64Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	return unmarshalInteger64BE[T](bs)
+}
+
+// Size returns the size of an encoded (Raw, big-endian) 64-bit integer value.
+func (s beInteger64Ser[T]) Size(v T) (size int) {
+	return com.Num64RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) 64-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beInteger64Ser[T]) Skip(bs []byte) (n int, err error) {
+	return SkipInteger64(bs)
+}
+
+// integer32 -------------------------------------------------------------------
+
+type beInteger32Ser[T com.Integer32] struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) 32-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beInteger32Ser[T]) Marshal(v T, bs []byte) (n int) {
+	return marshalInteger32BE(v, bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) 32-bit integer value from bs.
+//
+// In addition to the integer value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beInteger32Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	return unmarshalInteger32BE[T](bs)
+}
+
+// Size returns the size of an encoded (Raw, big-endian) 32-bit integer value.
+func (s beInteger32Ser[T]) Size(v T) (size int) {
+	return com.Num32RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) 32-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beInteger32Ser[T]) Skip(bs []byte) (n int, err error) {
+	return SkipInteger32(bs)
+}
+
+// integer16 -------------------------------------------------------------------
+
+type beInteger16Ser[T com.Integer16] struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) 16-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beInteger16Ser[T]) Marshal(v T, bs []byte) (n int) {
+	_ = bs[1]
+	bs[0] = byte(v >> 8)
+	bs[1] = byte(v)
+	return com.Num16RawSize
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) 16-bit integer value from bs.
+//
+// In addition to the integer value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beInteger16Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	if len(bs) < com.Num16RawSize {
+		return v, 0, mus.ErrTooSmallByteSlice
+	}
+	_ = bs[1]
+	return T(bs[0])<<8 | T(bs[1]), com.Num16RawSize, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) 16-bit integer value.
+func (s beInteger16Ser[T]) Size(v T) (size int) {
+	return com.Num16RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) 16-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beInteger16Ser[T]) Skip(bs []byte) (n int, err error) {
+	return SkipInteger16(bs)
+}
+
+// float64 ---------------------------------------------------------------------
+
+type beFloat64Ser struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) float64 value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beFloat64Ser) Marshal(v float64, bs []byte) (n int) {
+	return marshalInteger64BE(math.Float64bits(v), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) float64 value from bs.
+//
+// In addition to the float64 value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beFloat64Ser) Unmarshal(bs []byte) (v float64, n int, err error) {
+	uv, n, err := unmarshalInteger64BE[uint64](bs)
+	if err != nil {
+		return
+	}
+	return math.Float64frombits(uv), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) float64 value.
+func (s beFloat64Ser) Size(v float64) (size int) {
+	return com.Num64RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) float64 value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beFloat64Ser) Skip(bs []byte) (n int, err error) {
+	return SkipInteger64(bs)
+}
+
+// float32 ---------------------------------------------------------------------
+
+type beFloat32Ser struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) float32 value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beFloat32Ser) Marshal(v float32, bs []byte) (n int) {
+	return marshalInteger32BE(math.Float32bits(v), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) float32 value from bs.
+//
+// In addition to the float32 value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beFloat32Ser) Unmarshal(bs []byte) (v float32, n int, err error) {
+	uv, n, err := unmarshalInteger32BE[uint32](bs)
+	if err != nil {
+		return
+	}
+	return math.Float32frombits(uv), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) float32 value.
+func (s beFloat32Ser) Size(v float32) (size int) {
+	return com.Num32RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) float32 value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beFloat32Ser) Skip(bs []byte) (n int, err error) {
+	return SkipInteger32(bs)
+}
+
+// -----------------------------------------------------------------------------
+
+func marshalInteger64BE[T com.Integer64](t T, bs []byte) int {
+	_ = bs[7]
+	bs[0] = byte(t >> 56)
+	bs[1] = byte(t >> 48)
+	bs[2] = byte(t >> 40)
+	bs[3] = byte(t >> 32)
+	bs[4] = byte(t >> 24)
+	bs[5] = byte(t >> 16)
+	bs[6] = byte(t >> 8)
+	bs[7] = byte(t)
+	return com.Num64RawSize
+}
+
+func marshalInteger32BE[T com.Integer32](t T, bs []byte) int {
+	_ = bs[3]
+	bs[0] = byte(t >> 24)
+	bs[1] = byte(t >> 16)
+	bs[2] = byte(t >> 8)
+	bs[3] = byte(t)
+	return com.Num32RawSize
+}
+
+func unmarshalInteger64BE[T com.Integer64](bs []byte) (T, int, error) {
+	var t T
+	if len(bs) < com.Num64RawSize {
+		return t, 0, mus.ErrTooSmallByteSlice
+	}
+	_ = bs[7]
+	t = T(bs[0]) << 56
+	t |= T(bs[1]) << 48
+	t |= T(bs[2]) << 40
+	t |= T(bs[3]) << 32
+	t |= T(bs[4]) << 24
+	t |= T(bs[5]) << 16
+	t |= T(bs[6]) << 8
+	t |= T(bs[7])
+	return t, com.Num64RawSize, nil
+}
+
+func unmarshalInteger32BE[T com.Integer32](bs []byte) (T, int, error) {
+	var t T
+	if len(bs) < com.Num32RawSize {
+		return t, 0, mus.ErrTooSmallByteSlice
+	}
+	_ = bs[3]
+	t = T(bs[0]) << 24
+	t |= T(bs[1]) << 16
+	t |= T(bs[2]) << 8
+	t |= T(bs[3])
+	return t, com.Num32RawSize, nil
+}
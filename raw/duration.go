@@ -0,0 +1,41 @@
+package raw
+
+import (
+	"time"
+
+	com "github.com/mus-format/common-go"
+)
+
+// Duration is a time.Duration serializer that encodes a value as 8 bytes of
+// nanoseconds.
+var Duration = durationSer{}
+
+type durationSer struct{}
+
+// Marshal fills bs with an encoded (Raw) time.Duration value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s durationSer) Marshal(v time.Duration, bs []byte) (n int) {
+	return marshalInteger64(v, bs)
+}
+
+// Unmarshal parses an encoded (Raw) time.Duration value from bs.
+//
+// In addition to the time.Duration value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice.
+func (s durationSer) Unmarshal(bs []byte) (v time.Duration, n int, err error) {
+	return unmarshalInteger64[time.Duration](bs)
+}
+
+// Size returns the size of an encoded (Raw) time.Duration value.
+func (s durationSer) Size(v time.Duration) (size int) {
+	return com.Num64RawSize
+}
+
+// Skip skips an encoded (Raw) time.Duration value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s durationSer) Skip(bs []byte) (n int, err error) {
+	return SkipInteger64(bs)
+}
@@ -0,0 +1,158 @@
+package raw
+
+import (
+	"math"
+
+	com "github.com/mus-format/common-go"
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	// Float16 is a float32 serializer that encodes a value as IEEE 754
+	// half-precision float (2 bytes).
+	Float16 = NewFloat16Ser[float32]()
+	// BFloat16 is a float32 serializer that encodes a value as bfloat16
+	// (2 bytes).
+	BFloat16 = NewBFloat16Ser[float32]()
+)
+
+// NewFloat16Ser returns a new serializer that encodes a float32 or float64
+// value as IEEE 754 half-precision float (5 exponent bits, 10 mantissa bits).
+// Values are rounded to the nearest even, too large ones become infinities.
+func NewFloat16Ser[T constraints.Float]() float16Ser[T] {
+	return float16Ser[T]{format: float16Format}
+}
+
+// NewBFloat16Ser returns a new serializer that encodes a float32 or float64
+// value as bfloat16 (8 exponent bits, 7 mantissa bits), which has the same
+// range as float32, but less precision. Values are rounded to the nearest
+// even.
+func NewBFloat16Ser[T constraints.Float]() float16Ser[T] {
+	return float16Ser[T]{format: bfloat16Format}
+}
+
+type float16Ser[T constraints.Float] struct {
+	format halfFormat
+}
+
+// Marshal fills bs with an encoded (Raw) 16-bit float value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s float16Ser[T]) Marshal(v T, bs []byte) (n int) {
+	return marshalInteger16(s.format.fromFloat64(float64(v)), bs)
+}
+
+// Unmarshal parses an encoded (Raw) 16-bit float value from bs.
+//
+// In addition to the float value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s float16Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	h, n, err := unmarshalInteger16[uint16](bs)
+	if err != nil {
+		return
+	}
+	return T(s.format.toFloat64(h)), n, nil
+}
+
+// Size returns the size of an encoded (Raw) 16-bit float value.
+func (s float16Ser[T]) Size(v T) (size int) {
+	return com.Num16RawSize
+}
+
+// Skip skips an encoded (Raw) 16-bit float value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s float16Ser[T]) Skip(bs []byte) (n int, err error) {
+	return SkipInteger16(bs)
+}
+
+// -----------------------------------------------------------------------------
+
+var (
+	float16Format  = halfFormat{expBits: 5, mantBits: 10}
+	bfloat16Format = halfFormat{expBits: 8, mantBits: 7}
+)
+
+// halfFormat describes a 16-bit binary floating-point format: 1 sign bit +
+// expBits + mantBits.
+type halfFormat struct {
+	expBits  uint
+	mantBits uint
+}
+
+func (f halfFormat) bias() int {
+	return 1<<(f.expBits-1) - 1
+}
+
+func (f halfFormat) maxExp() uint16 {
+	return 1<<f.expBits - 1
+}
+
+func (f halfFormat) fromFloat64(v float64) (h uint16) {
+	var (
+		b    = math.Float64bits(v)
+		sign = uint16(b>>48) & 0x8000
+		exp  = int(b>>52) & 0x7ff
+		mant = b & (1<<52 - 1)
+		inf  = f.maxExp() << f.mantBits
+	)
+	switch {
+	case exp == 0x7ff && mant != 0:
+		// NaN stays a quiet NaN, keeping the top bits of the payload.
+		return sign | inf | 1<<(f.mantBits-1) | uint16(mant>>(52-f.mantBits))
+	case exp == 0x7ff:
+		return sign | inf
+	case exp == 0 && mant == 0:
+		return sign
+	}
+	var (
+		e     = exp - 1023 + f.bias()
+		shift = 52 - f.mantBits
+	)
+	if e >= int(f.maxExp()) {
+		return sign | inf
+	}
+	if e <= 0 {
+		// Subnormal.
+		mant |= 1 << 52
+		shift += uint(1 - e)
+		if shift >= 64 {
+			return sign
+		}
+		e = 0
+	}
+	h = uint16(e)<<f.mantBits + uint16(mant>>shift)
+	// Round to the nearest even, a carry goes to the exponent.
+	var (
+		rem  = mant & (1<<shift - 1)
+		half = uint64(1) << (shift - 1)
+	)
+	if rem > half || (rem == half && h&1 == 1) {
+		h++
+	}
+	return sign | h
+}
+
+func (f halfFormat) toFloat64(h uint16) float64 {
+	var (
+		sign = uint64(h&0x8000) << 48
+		exp  = (h & 0x7fff) >> f.mantBits
+		mant = uint64(h) & (1<<f.mantBits - 1)
+	)
+	switch {
+	case exp == f.maxExp() && mant != 0:
+		return math.Float64frombits(sign | 0x7ff<<52 | 1<<51 |
+			mant<<(52-f.mantBits))
+	case exp == f.maxExp():
+		return math.Float64frombits(sign | 0x7ff<<52)
+	case exp == 0:
+		v := math.Ldexp(float64(mant), 1-f.bias()-int(f.mantBits))
+		if sign != 0 {
+			v = -v
+		}
+		return v
+	}
+	return math.Float64frombits(sign | uint64(int(exp)-f.bias()+1023)<<52 |
+		mant<<(52-f.mantBits))
+}
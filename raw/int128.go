@@ -0,0 +1,61 @@
+package raw
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+// Num128RawSize is the size of an encoded (Raw) 128-bit integer.
+const Num128RawSize = 2 * com.Num64RawSize
+
+var (
+	// Uint128 is a 128-bit unsigned integer serializer. A value is represented
+	// as [2]uint64{hi, lo} and encoded as 16 bytes in little-endian order.
+	Uint128 = int128Ser{}
+	// Int128 is a 128-bit signed (two's complement) integer serializer. A value
+	// is represented as [2]uint64{hi, lo}, the encoding is the same as for
+	// Uint128.
+	Int128 = int128Ser{}
+)
+
+type int128Ser struct{}
+
+// Marshal fills bs with an encoded (Raw) 128-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s int128Ser) Marshal(v [2]uint64, bs []byte) (n int) {
+	_ = bs[Num128RawSize-1]
+	marshalInteger64(v[1], bs)
+	marshalInteger64(v[0], bs[com.Num64RawSize:])
+	return Num128RawSize
+}
+
+// Unmarshal parses an encoded (Raw) 128-bit integer value from bs.
+//
+// In addition to the 128-bit integer value and the number of used bytes, it
+// may also return mus.ErrTooSmallByteSlice.
+func (s int128Ser) Unmarshal(bs []byte) (v [2]uint64, n int, err error) {
+	if len(bs) < Num128RawSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	v[1], _, _ = unmarshalInteger64[uint64](bs)
+	v[0], _, _ = unmarshalInteger64[uint64](bs[com.Num64RawSize:])
+	return v, Num128RawSize, nil
+}
+
+// Size returns the size of an encoded (Raw) 128-bit integer value.
+func (s int128Ser) Size(v [2]uint64) (size int) {
+	return Num128RawSize
+}
+
+// Skip skips an encoded (Raw) 128-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s int128Ser) Skip(bs []byte) (n int, err error) {
+	if len(bs) < Num128RawSize {
+		return 0, mus.ErrTooSmallByteSlice
+	}
+	return Num128RawSize, nil
+}
@@ -0,0 +1,133 @@
+package raw
+
+import (
+	"errors"
+	"math"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	qntopts "github.com/mus-format/mus-go/options/quantize"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrInvalidQuantization means that the quantization range is empty or not
+// finite, or the number of bits is not in the [1, 32] range.
+var ErrInvalidQuantization = errors.New(com.ErrorPrefix +
+	"invalid quantization parameters")
+
+// NewQuantizedSer returns a new serializer that maps a float value from the
+// [lo, hi] range to an integer with the given number of bits, encoded in
+// (bits+7)/8 bytes. Values outside the range are clamped, NaN is encoded as
+// lo.
+//
+// The precision loss is not checked by Marshal, use Loss or, if the loss
+// validator is specified, ValidateLoss to check a value beforehand.
+//
+// Panics with ErrInvalidQuantization if the parameters are not valid.
+func NewQuantizedSer[T constraints.Float](lo, hi T, bits int,
+	opts ...qntopts.SetOption) quantizedSer[T] {
+	if !(lo < hi) || math.IsInf(float64(lo), 0) || math.IsInf(float64(hi), 0) ||
+		bits < 1 || bits > 32 {
+		panic(ErrInvalidQuantization)
+	}
+	o := qntopts.Options{}
+	qntopts.Apply(opts, &o)
+	return quantizedSer[T]{
+		min:    float64(lo),
+		max:    float64(hi),
+		maxQ:   1<<bits - 1,
+		size:   (bits + 7) / 8,
+		lossVl: o.LossVl,
+	}
+}
+
+type quantizedSer[T constraints.Float] struct {
+	min, max float64
+	maxQ     uint64
+	size     int
+	lossVl   com.Validator[float64]
+}
+
+// Marshal fills bs with an encoded quantized value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s quantizedSer[T]) Marshal(v T, bs []byte) (n int) {
+	q := s.quantize(v)
+	_ = bs[s.size-1]
+	for i := 0; i < s.size; i++ {
+		bs[i] = byte(q >> (8 * i))
+	}
+	return s.size
+}
+
+// Unmarshal parses an encoded quantized value from bs.
+//
+// In addition to the float value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice or com.ErrWrongFormat, if the encoded
+// integer does not fit the number of bits.
+func (s quantizedSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	if len(bs) < s.size {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	var q uint64
+	for i := 0; i < s.size; i++ {
+		q |= uint64(bs[i]) << (8 * i)
+	}
+	if q > s.maxQ {
+		err = com.ErrWrongFormat
+		return
+	}
+	return s.dequantize(q), s.size, nil
+}
+
+// Size returns the size of an encoded quantized value.
+func (s quantizedSer[T]) Size(v T) (size int) {
+	return s.size
+}
+
+// Skip skips an encoded quantized value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s quantizedSer[T]) Skip(bs []byte) (n int, err error) {
+	if len(bs) < s.size {
+		return 0, mus.ErrTooSmallByteSlice
+	}
+	return s.size, nil
+}
+
+// Loss returns the precision loss of v - the absolute difference between v
+// and the value that will be unmarshalled. For values from the range it does
+// not exceed half of the quantization step.
+func (s quantizedSer[T]) Loss(v T) float64 {
+	return s.loss(v, s.quantize(v))
+}
+
+// ValidateLoss applies the loss validator to the precision loss of v. Returns
+// nil if the validator is not specified.
+func (s quantizedSer[T]) ValidateLoss(v T) (err error) {
+	if s.lossVl != nil {
+		err = s.lossVl.Validate(s.Loss(v))
+	}
+	return
+}
+
+func (s quantizedSer[T]) quantize(v T) uint64 {
+	f := float64(v)
+	switch {
+	case math.IsNaN(f) || f <= s.min:
+		return 0
+	case f >= s.max:
+		return s.maxQ
+	}
+	return uint64(math.Round((f - s.min) / (s.max - s.min) * float64(s.maxQ)))
+}
+
+func (s quantizedSer[T]) dequantize(q uint64) T {
+	return T(s.min + (s.max-s.min)*float64(q)/float64(s.maxQ))
+}
+
+func (s quantizedSer[T]) loss(v T, q uint64) float64 {
+	return math.Abs(float64(v) - float64(s.dequantize(q)))
+}
@@ -1,12 +1,15 @@
 package raw
 
 import (
+	"math"
 	"testing"
 	"time"
 
 	"github.com/mus-format/mus-go/test"
 )
 
+const maxLen = 1000
+
 // byte ------------------------------------------------------------------------
 
 func FuzzRaw_Byte(f *testing.F) {
@@ -267,6 +270,46 @@ func FuzzRaw_Float32Unmarshal(f *testing.F) {
 	})
 }
 
+// complex128 ------------------------------------------------------------------
+
+func FuzzRaw_Complex128(f *testing.F) {
+	seeds := []float64{0, 1, -1, 3.14}
+	for _, seed := range seeds {
+		f.Add(seed, -seed)
+	}
+	f.Fuzz(func(t *testing.T, re, im float64) {
+		test.Test([]complex128{complex(re, im)}, Complex128, t)
+		test.TestSkip([]complex128{complex(re, im)}, Complex128, t)
+	})
+}
+
+func FuzzRaw_Complex128Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Complex128.Unmarshal(bs)
+		Complex128.Skip(bs)
+	})
+}
+
+// complex64 -------------------------------------------------------------------
+
+func FuzzRaw_Complex64(f *testing.F) {
+	seeds := []float32{0, 1, -1, 3.14}
+	for _, seed := range seeds {
+		f.Add(seed, -seed)
+	}
+	f.Fuzz(func(t *testing.T, re, im float32) {
+		test.Test([]complex64{complex(re, im)}, Complex64, t)
+		test.TestSkip([]complex64{complex(re, im)}, Complex64, t)
+	})
+}
+
+func FuzzRaw_Complex64Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Complex64.Unmarshal(bs)
+		Complex64.Skip(bs)
+	})
+}
+
 // time ------------------------------------------------------------------------
 
 func FuzzRaw_TimeUnixUTC(f *testing.F) {
@@ -311,5 +354,188 @@ func FuzzRaw_TimeUnmarshal(f *testing.F) {
 		TimeUnixMicroUTC.Skip(bs)
 		TimeUnixNanoUTC.Unmarshal(bs)
 		TimeUnixNanoUTC.Skip(bs)
+		TimeWithOffset.Unmarshal(bs)
+		TimeWithOffset.Skip(bs)
+		TimeWithZone.Unmarshal(bs)
+		TimeWithZone.Skip(bs)
+	})
+}
+
+func FuzzRaw_TimeWithOffset(f *testing.F) {
+	f.Fuzz(func(t *testing.T, sec int64, nsec uint32, offset int32) {
+		v := time.Unix(sec%(1<<40), int64(nsec%1000000000)).In(
+			time.FixedZone("", int(offset%(24*60*60))))
+		test.Test([]time.Time{v}, TimeWithOffset, t)
+		test.TestSkip([]time.Time{v}, TimeWithOffset, t)
+	})
+}
+
+// float16 ---------------------------------------------------------------------
+
+func FuzzRaw_Float16(f *testing.F) {
+	f.Fuzz(func(t *testing.T, h uint16) {
+		// Every 16-bit float is exactly representable as float32, so it should
+		// survive a round trip.
+		for _, ser := range []float16Ser[float32]{Float16, BFloat16} {
+			bs := []byte{byte(h), byte(h >> 8)}
+			v, _, err := ser.Unmarshal(bs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.IsNaN(float64(v)) {
+				continue
+			}
+			test.Test([]float32{v}, ser, t)
+			test.TestSkip([]float32{v}, ser, t)
+		}
+	})
+}
+
+func FuzzRaw_Float16Marshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, v float64) {
+		bs := make([]byte, 2)
+		NewFloat16Ser[float64]().Marshal(v, bs)
+		NewBFloat16Ser[float64]().Marshal(v, bs)
+	})
+}
+
+func FuzzRaw_Float16Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Float16.Unmarshal(bs)
+		Float16.Skip(bs)
+		BFloat16.Unmarshal(bs)
+		BFloat16.Skip(bs)
+	})
+}
+
+// quantized -------------------------------------------------------------------
+
+func FuzzRaw_Quantized(f *testing.F) {
+	f.Fuzz(func(t *testing.T, v float64, bits uint8) {
+		var (
+			ser  = NewQuantizedSer[float64](-100, 100, int(bits%32)+1)
+			step = 200 / float64(ser.maxQ)
+			bs   = make([]byte, ser.Size(v))
+		)
+		ser.Marshal(v, bs)
+		av, n, err := ser.Unmarshal(bs)
+		if err != nil || n != len(bs) {
+			t.Fatalf("unexpected n %v or err %v", n, err)
+		}
+		if v >= -100 && v <= 100 && math.Abs(v-av) > step/2+1e-9 {
+			t.Errorf("too large loss for %v: %v", v, math.Abs(v-av))
+		}
+	})
+}
+
+func FuzzRaw_QuantizedUnmarshal(f *testing.F) {
+	ser := NewQuantizedSer[float32](0, 1, 20)
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
+
+// int128 ----------------------------------------------------------------------
+
+func FuzzRaw_Int128(f *testing.F) {
+	f.Fuzz(func(t *testing.T, hi, lo uint64) {
+		v := [2]uint64{hi, lo}
+		test.Test([][2]uint64{v}, Uint128, t)
+		test.TestSkip([][2]uint64{v}, Uint128, t)
+		test.Test([][2]uint64{v}, Int128, t)
+		test.TestSkip([][2]uint64{v}, Int128, t)
+	})
+}
+
+func FuzzRaw_Int128Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Uint128.Unmarshal(bs)
+		Uint128.Skip(bs)
+		Int128.Unmarshal(bs)
+		Int128.Skip(bs)
+	})
+}
+
+// uuid ------------------------------------------------------------------------
+
+func FuzzRaw_UUID(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		var v [16]byte
+		copy(v[:], bs)
+		test.Test([][16]byte{v}, UUID, t)
+		test.TestSkip([][16]byte{v}, UUID, t)
+		UUID.Unmarshal(bs)
+		UUID.Skip(bs)
+	})
+}
+
+// be --------------------------------------------------------------------------
+
+func FuzzRaw_BE(f *testing.F) {
+	f.Fuzz(func(t *testing.T, v uint64, fv float64) {
+		test.Test([]uint64{v}, BE.Uint64, t)
+		test.TestSkip([]uint64{v}, BE.Uint64, t)
+		test.Test([]int64{int64(v)}, BE.Int64, t)
+		test.Test([]uint32{uint32(v)}, BE.Uint32, t)
+		test.Test([]int32{int32(v)}, BE.Int32, t)
+		test.Test([]uint16{uint16(v)}, BE.Uint16, t)
+		test.Test([]int16{int16(v)}, BE.Int16, t)
+		test.Test([]float64{fv}, BE.Float64, t)
+		test.Test([]float32{float32(fv)}, BE.Float32, t)
+	})
+}
+
+func FuzzRaw_BEUnmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		BE.Uint64.Unmarshal(bs)
+		BE.Uint64.Skip(bs)
+		BE.Int32.Unmarshal(bs)
+		BE.Int32.Skip(bs)
+		BE.Uint16.Unmarshal(bs)
+		BE.Uint16.Skip(bs)
+		BE.Float64.Unmarshal(bs)
+		BE.Float32.Unmarshal(bs)
+	})
+}
+
+// duration --------------------------------------------------------------------
+
+func FuzzRaw_Duration(f *testing.F) {
+	f.Fuzz(func(t *testing.T, v int64) {
+		test.Test([]time.Duration{time.Duration(v)}, Duration, t)
+		test.TestSkip([]time.Duration{time.Duration(v)}, Duration, t)
+	})
+}
+
+func FuzzRaw_DurationUnmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Duration.Unmarshal(bs)
+		Duration.Skip(bs)
+	})
+}
+
+// slice -----------------------------------------------------------------------
+
+func FuzzRaw_Slice(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		if len(bs) > maxLen {
+			bs = bs[:maxLen]
+		}
+		v := make([]float64, len(bs))
+		for i, b := range bs {
+			v[i] = float64(b) / 3
+		}
+		ser := NewSliceSer[float64]()
+		test.Test([][]float64{v}, ser, t)
+		test.TestSkip([][]float64{v}, ser, t)
+	})
+}
+
+func FuzzRaw_SliceUnmarshal(f *testing.F) {
+	ser := NewSliceSer[int32]()
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
 	})
 }
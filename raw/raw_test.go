@@ -1,15 +1,25 @@
 package raw
 
 import (
+	"encoding/binary"
+	"errors"
+	"math"
 	"os"
 	"testing"
 	"time"
 
 	com "github.com/mus-format/common-go"
 	ctest "github.com/mus-format/common-go/test"
+	cmock "github.com/mus-format/common-go/test/mock"
 	"github.com/mus-format/mus-go"
+	qntopts "github.com/mus-format/mus-go/options/quantize"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/ord"
 	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
 	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+	"github.com/ymz-ncnk/mok"
 )
 
 func TestRaw_setUpUintFuncs(t *testing.T) {
@@ -328,6 +338,62 @@ func TestRaw_Float32(t *testing.T) {
 		})
 }
 
+func TestRaw_Complex128(t *testing.T) {
+	t.Run("Complex128 serializer should succeed", func(t *testing.T) {
+		cases := []complex128{0, 1 + 2i, -3.5 - 0.25i,
+			complex(math.MaxFloat64, math.SmallestNonzeroFloat64),
+			complex(math.Inf(1), math.NaN())}
+		test.Test(cases, Complex128, t)
+		test.TestSkip(cases, Complex128, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no imaginary part",
+		func(t *testing.T) {
+			var (
+				v    = complex(1.5, 2.5)
+				bs   = make([]byte, Complex128.Size(v))
+				want = test.UnmarshalResult[complex128]{
+					N:   8,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			Complex128.Marshal(v, bs)
+			bs = bs[:Float64.Size(real(v))+1]
+			test.TestUnmarshalOnly(bs, Complex128, want, nil, t)
+			n, err := Complex128.Skip(bs)
+			asserterror.Equal(t, n, want.N)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestRaw_Complex64(t *testing.T) {
+	t.Run("Complex64 serializer should succeed", func(t *testing.T) {
+		cases := []complex64{0, 1 + 2i, -3.5 - 0.25i,
+			complex(math.MaxFloat32, math.SmallestNonzeroFloat32),
+			complex(float32(math.Inf(-1)), float32(math.NaN()))}
+		test.Test(cases, Complex64, t)
+		test.TestSkip(cases, Complex64, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no imaginary part",
+		func(t *testing.T) {
+			var (
+				v    = complex64(complex(1.5, 2.5))
+				bs   = make([]byte, Complex64.Size(v))
+				want = test.UnmarshalResult[complex64]{
+					N:   4,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			Complex64.Marshal(v, bs)
+			bs = bs[:Float32.Size(real(v))+1]
+			test.TestUnmarshalOnly(bs, Complex64, want, nil, t)
+			n, err := Complex64.Skip(bs)
+			asserterror.Equal(t, n, want.N)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
 func TestRaw_TimeUnixUTC(t *testing.T) {
 	os.Setenv("TZ", "")
 
@@ -446,3 +512,616 @@ func TestRaw_TimeUnixNanoUTC(t *testing.T) {
 			test.TestUnmarshalOnly(bs, TimeUnixNanoUTC, want, nil, t)
 		})
 }
+
+func TestRaw_TimeWithOffset(t *testing.T) {
+	t.Run("TimeWithOffset serializer should preserve the offset",
+		func(t *testing.T) {
+			for _, tm := range []time.Time{
+				time.Date(2024, 3, 10, 9, 30, 0, 123456789,
+					time.FixedZone("", 3*60*60)),
+				time.Date(1960, 1, 1, 0, 0, 0, 0, time.FixedZone("", -9*60*60-30*60)),
+				time.Unix(1700000000, 1).UTC(),
+			} {
+				test.Test([]time.Time{tm}, TimeWithOffset, t)
+				test.TestSkip([]time.Time{tm}, TimeWithOffset, t)
+
+				bs := make([]byte, TimeWithOffset.Size(tm))
+				TimeWithOffset.Marshal(tm, bs)
+				v, _, err := TimeWithOffset.Unmarshal(bs)
+				assertfatal.EqualError(t, err, nil)
+				asserterror.Equal(t, v.Format(time.RFC3339Nano),
+					tm.Format(time.RFC3339Nano))
+			}
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if nanoseconds are out of range",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[time.Time]{
+					N:   16,
+					Err: com.ErrWrongFormat,
+				}
+				bs = make([]byte, 16)
+			)
+			marshalInteger32(uint32(1000000000), bs[8:])
+			test.TestUnmarshalOnly(bs, TimeWithOffset, want, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[time.Time]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = make([]byte, 15)
+			)
+			test.TestUnmarshalOnly(bs, TimeWithOffset, want, nil, t)
+			test.TestSkipOnly(bs, TimeWithOffset,
+				test.SkipResult{Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+}
+
+func TestRaw_TimeWithZone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assertfatal.EqualError(t, err, nil)
+
+	t.Run("TimeWithZone serializer should preserve the location",
+		func(t *testing.T) {
+			for _, tm := range []time.Time{
+				time.Date(2024, 7, 1, 9, 30, 0, 5, newYork),
+				time.Date(2024, 1, 1, 9, 30, 0, 5, newYork),
+				time.Date(2024, 1, 1, 9, 30, 0, 5, time.UTC),
+			} {
+				test.Test([]time.Time{tm}, TimeWithZone, t)
+				test.TestSkip([]time.Time{tm}, TimeWithZone, t)
+
+				bs := make([]byte, TimeWithZone.Size(tm))
+				TimeWithZone.Marshal(tm, bs)
+				v, _, err := TimeWithZone.Unmarshal(bs)
+				assertfatal.EqualError(t, err, nil)
+				asserterror.Equal(t, v.Location().String(), tm.Location().String())
+				asserterror.Equal(t, v.Format(time.RFC3339Nano),
+					tm.Format(time.RFC3339Nano))
+				// The location should work for other instants as well.
+				asserterror.Equal(t, v.AddDate(0, 6, 0).Format(time.RFC3339),
+					tm.AddDate(0, 6, 0).Format(time.RFC3339))
+			}
+		})
+
+	t.Run("Unmarshal should fall back to a fixed zone if the location can't be loaded",
+		func(t *testing.T) {
+			var (
+				tm = time.Date(2024, 1, 1, 9, 30, 0, 0,
+					time.FixedZone("Mars/Olympus_Mons", 2*60*60))
+				bs = make([]byte, TimeWithZone.Size(tm))
+			)
+			TimeWithZone.Marshal(tm, bs)
+			v, _, err := TimeWithZone.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			name, offset := v.Zone()
+			asserterror.Equal(t, name, "Mars/Olympus_Mons")
+			asserterror.Equal(t, offset, 2*60*60)
+			asserterror.Equal(t, v.Equal(tm), true)
+		})
+
+	t.Run("Names that can't be loaded should be cached", func(t *testing.T) {
+		name := "Mars/Arsia_Mons"
+		_, ok := loadLocation(name)
+		asserterror.Equal(t, ok, false)
+		unknownLocationsMu.Lock()
+		_, pst := unknownLocations[name]
+		unknownLocationsMu.Unlock()
+		asserterror.Equal(t, pst, true, "name is not cached")
+		_, ok = loadLocation(name)
+		asserterror.Equal(t, ok, false)
+	})
+
+	t.Run("Value in time.Local should keep its instant and offset",
+		func(t *testing.T) {
+			var (
+				tm = time.Date(2024, 1, 1, 9, 30, 0, 0, time.Local)
+				bs = make([]byte, TimeWithZone.Size(tm))
+			)
+			TimeWithZone.Marshal(tm, bs)
+			v, _, err := TimeWithZone.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			asserterror.Equal(t, v.Format(time.RFC3339), tm.Format(time.RFC3339))
+			asserterror.Equal(t, v.Equal(tm), true)
+		})
+
+	t.Run("Unmarshal should fall back to a fixed zone if the offset differs from the location one",
+		func(t *testing.T) {
+			var (
+				tm = time.Date(2024, 1, 1, 9, 30, 0, 0,
+					time.FixedZone("America/New_York", 1*60*60))
+				bs = make([]byte, TimeWithZone.Size(tm))
+			)
+			TimeWithZone.Marshal(tm, bs)
+			v, _, err := TimeWithZone.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			_, offset := v.Zone()
+			asserterror.Equal(t, offset, 1*60*60)
+			asserterror.Equal(t, v.Format(time.RFC3339), tm.Format(time.RFC3339))
+		})
+
+	t.Run("Unmarshal and Skip should return ErrTooSmallByteSlice if there is no space for the zone name",
+		func(t *testing.T) {
+			var (
+				tm = time.Date(2024, 1, 1, 9, 30, 0, 0, newYork)
+				bs = make([]byte, TimeWithZone.Size(tm))
+			)
+			TimeWithZone.Marshal(tm, bs)
+			_, _, err := TimeWithZone.Unmarshal(bs[:len(bs)-1])
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, err = TimeWithZone.Skip(bs[:len(bs)-1])
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestRaw_Float16(t *testing.T) {
+	t.Run("Float16 serializer should succeed", func(t *testing.T) {
+		cases := []float32{0, float32(math.Copysign(0, -1)), 1, -2, 0.5, 65504,
+			float32(math.Ldexp(1, -24)), float32(math.Ldexp(1, -14)),
+			float32(math.Inf(1)), float32(math.Inf(-1))}
+		test.Test(cases, Float16, t)
+		test.TestSkip(cases, Float16, t)
+		test.Test([]float64{1.5, -0.25}, NewFloat16Ser[float64](), t)
+	})
+
+	t.Run("Float16 should round values to the nearest even", func(t *testing.T) {
+		for _, c := range []struct {
+			v    float32
+			want uint16
+		}{
+			{0.1, 0x2e66},
+			{65519, 0x7bff},
+			{65520, 0x7c00},
+			{float32(math.Ldexp(1, -25)), 0x0000},
+			{float32(math.Ldexp(3, -26)), 0x0001},
+			{1e-8, 0x0000},
+		} {
+			bs := make([]byte, Float16.Size(c.v))
+			Float16.Marshal(c.v, bs)
+			asserterror.EqualDeep(t, bs, []byte{byte(c.want), byte(c.want >> 8)})
+		}
+	})
+
+	t.Run("Float16 should keep NaN", func(t *testing.T) {
+		bs := make([]byte, Float16.Size(0))
+		Float16.Marshal(float32(math.NaN()), bs)
+		v, _, err := Float16.Unmarshal(bs)
+		asserterror.EqualError(t, err, nil)
+		asserterror.Equal(t, math.IsNaN(float64(v)), true)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[float32]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = []byte{1}
+			)
+			test.TestUnmarshalOnly(bs, Float16, want, nil, t)
+		})
+}
+
+func TestRaw_BFloat16(t *testing.T) {
+	t.Run("BFloat16 serializer should succeed", func(t *testing.T) {
+		cases := []float32{0, 1, -2, 0.5, 65536, float32(math.Ldexp(1, 127)),
+			float32(math.Ldexp(1, -133)), float32(math.Inf(-1))}
+		test.Test(cases, BFloat16, t)
+		test.TestSkip(cases, BFloat16, t)
+		test.Test([]float64{1.5, -0.25}, NewBFloat16Ser[float64](), t)
+	})
+
+	t.Run("BFloat16 should keep the top 16 bits of float32 rounded to the nearest even",
+		func(t *testing.T) {
+			for _, v := range []float32{0.1, 3.14159, -1e30, 1.00390625} {
+				var (
+					b    = math.Float32bits(v)
+					want = uint16(b >> 16)
+					rem  = b & 0xffff
+					bs   = make([]byte, BFloat16.Size(v))
+				)
+				if rem > 0x8000 || (rem == 0x8000 && want&1 == 1) {
+					want++
+				}
+				BFloat16.Marshal(v, bs)
+				asserterror.EqualDeep(t, bs, []byte{byte(want), byte(want >> 8)})
+			}
+		})
+}
+
+func TestRaw_Quantized(t *testing.T) {
+	t.Run("Quantized serializer should succeed", func(t *testing.T) {
+		ser := NewQuantizedSer[float64](-1000, 3095, 12)
+		cases := []float64{-1000, 3095, 0, 1.0, -999}
+		test.Test(cases, ser, t)
+		test.TestSkip(cases, ser, t)
+		asserterror.Equal(t, ser.Size(0), 2)
+	})
+
+	t.Run("Loss should not exceed half of the step for values from the range",
+		func(t *testing.T) {
+			var (
+				ser  = NewQuantizedSer[float32](0, 10, 8)
+				step = 10.0 / 255
+			)
+			for _, v := range []float32{0, 0.01, 3.3333, 9.99, 10} {
+				var (
+					bs = make([]byte, ser.Size(v))
+				)
+				ser.Marshal(v, bs)
+				av, _, err := ser.Unmarshal(bs)
+				asserterror.EqualError(t, err, nil)
+				loss := math.Abs(float64(v) - float64(av))
+				asserterror.Equal(t, loss <= step/2+1e-6, true)
+				asserterror.Equal(t, ser.Loss(v), loss)
+			}
+		})
+
+	t.Run("Values outside the range should be clamped", func(t *testing.T) {
+		ser := NewQuantizedSer[float64](0, 1, 16)
+		for _, c := range []struct {
+			v, want float64
+		}{
+			{-5, 0}, {5, 1}, {math.Inf(1), 1}, {math.NaN(), 0},
+		} {
+			bs := make([]byte, ser.Size(c.v))
+			ser.Marshal(c.v, bs)
+			av, _, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, nil)
+			asserterror.Equal(t, av, c.want)
+		}
+		asserterror.Equal(t, ser.Loss(5), 4.0)
+	})
+
+	t.Run("If lossVl returns an error, ValidateLoss should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("too large loss")
+				ser     = NewQuantizedSer[float64](0, 1, 8,
+					qntopts.WithLossValidator(com.ValidatorFn[float64](
+						func(l float64) (err error) {
+							if l > 0.01 {
+								err = wantErr
+							}
+							return
+						})))
+				bs = make([]byte, ser.Size(0))
+			)
+			asserterror.EqualError(t, ser.ValidateLoss(0.5), nil)
+			asserterror.EqualError(t, ser.ValidateLoss(2), wantErr)
+			// Marshal does not validate the loss.
+			asserterror.Equal(t, ser.Marshal(2, bs), 1)
+		})
+
+	t.Run("ValidateLoss should return nil if lossVl is not specified",
+		func(t *testing.T) {
+			ser := NewQuantizedSer[float64](0, 1, 8)
+			asserterror.EqualError(t, ser.ValidateLoss(2), nil)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if the value does not fit the bits",
+		func(t *testing.T) {
+			var (
+				ser  = NewQuantizedSer[float64](0, 1, 12)
+				want = test.UnmarshalResult[float64]{
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly([]byte{0xff, 0x10}, ser, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				ser  = NewQuantizedSer[float64](0, 1, 24)
+				want = test.UnmarshalResult[float64]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = []byte{1, 2}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+			test.TestSkipOnly(bs, ser, test.SkipResult{
+				Err: mus.ErrTooSmallByteSlice,
+			}, nil, t)
+		})
+
+	t.Run("NewQuantizedSer should panic with ErrInvalidQuantization if the parameters are not valid",
+		func(t *testing.T) {
+			for _, f := range []func(){
+				func() { NewQuantizedSer[float64](1, 1, 8) },
+				func() { NewQuantizedSer(0, math.Inf(1), 8) },
+				func() { NewQuantizedSer(0, math.NaN(), 8) },
+				func() { NewQuantizedSer[float64](0, 1, 0) },
+				func() { NewQuantizedSer[float64](0, 1, 33) },
+			} {
+				func() {
+					defer func() {
+						asserterror.Equal[any](t, recover(), ErrInvalidQuantization)
+					}()
+					f()
+				}()
+			}
+		})
+}
+
+func TestRaw_Int128(t *testing.T) {
+	t.Run("Uint128 and Int128 serializers should succeed", func(t *testing.T) {
+		cases := [][2]uint64{{0, 0}, {0, 1}, {1, 0},
+			{math.MaxUint64, math.MaxUint64}, {1 << 63, 0}}
+		test.Test(cases, Uint128, t)
+		test.TestSkip(cases, Uint128, t)
+		test.Test(cases, Int128, t)
+		test.TestSkip(cases, Int128, t)
+	})
+
+	t.Run("Uint128 should be encoded in little-endian order", func(t *testing.T) {
+		var (
+			v      = [2]uint64{0x0102, 0x0304}
+			wantBS = []byte{4, 3, 0, 0, 0, 0, 0, 0, 2, 1, 0, 0, 0, 0, 0, 0}
+			bs     = make([]byte, Uint128.Size(v))
+		)
+		Uint128.Marshal(v, bs)
+		asserterror.EqualDeep(t, bs, wantBS)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[[2]uint64]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = make([]byte, Num128RawSize-1)
+			)
+			test.TestUnmarshalOnly(bs, Uint128, want, nil, t)
+			test.TestSkipOnly(bs, Uint128, test.SkipResult{
+				Err: mus.ErrTooSmallByteSlice,
+			}, nil, t)
+		})
+}
+
+func TestRaw_UUID(t *testing.T) {
+	t.Run("UUID serializer should succeed", func(t *testing.T) {
+		cases := [][16]byte{{}, {0: 1, 15: 255},
+			{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}}
+		test.Test(cases, UUID, t)
+		test.TestSkip(cases, UUID, t)
+	})
+
+	t.Run("UUID should be encoded as 16 bytes without a length",
+		func(t *testing.T) {
+			var (
+				v  = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+				bs = make([]byte, UUID.Size(v))
+			)
+			asserterror.Equal(t, UUID.Marshal(v, bs), 16)
+			asserterror.EqualDeep(t, bs, v[:])
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[[16]byte]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = make([]byte, UUIDSize-1)
+			)
+			test.TestUnmarshalOnly(bs, UUID, want, nil, t)
+			test.TestSkipOnly(bs, UUID, test.SkipResult{
+				Err: mus.ErrTooSmallByteSlice,
+			}, nil, t)
+		})
+}
+
+func TestRaw_BE(t *testing.T) {
+	t.Run("BE serializers should succeed", func(t *testing.T) {
+		test.Test(ctest.Uint64TestCases, BE.Uint64, t)
+		test.TestSkip(ctest.Uint64TestCases, BE.Uint64, t)
+		test.Test(ctest.Uint32TestCases, BE.Uint32, t)
+		test.TestSkip(ctest.Uint32TestCases, BE.Uint32, t)
+		test.Test(ctest.Uint16TestCases, BE.Uint16, t)
+		test.TestSkip(ctest.Uint16TestCases, BE.Uint16, t)
+		test.Test(ctest.Uint8TestCases, BE.Uint8, t)
+		test.Test(ctest.Int64TestCases, BE.Int64, t)
+		test.TestSkip(ctest.Int64TestCases, BE.Int64, t)
+		test.Test(ctest.Int32TestCases, BE.Int32, t)
+		test.TestSkip(ctest.Int32TestCases, BE.Int32, t)
+		test.Test(ctest.Int16TestCases, BE.Int16, t)
+		test.TestSkip(ctest.Int16TestCases, BE.Int16, t)
+		test.Test(ctest.Int8TestCases, BE.Int8, t)
+		test.Test(ctest.Float64TestCases, BE.Float64, t)
+		test.TestSkip(ctest.Float64TestCases, BE.Float64, t)
+		test.Test(ctest.Float32TestCases, BE.Float32, t)
+		test.TestSkip(ctest.Float32TestCases, BE.Float32, t)
+	})
+
+	t.Run("BE serializers should use the big-endian byte order",
+		func(t *testing.T) {
+			bs := make([]byte, 8)
+			BE.Uint64.Marshal(0x0102030405060708, bs)
+			asserterror.EqualDeep(t, bs, binary.BigEndian.AppendUint64(nil,
+				0x0102030405060708))
+			BE.Int32.Marshal(-2, bs)
+			asserterror.EqualDeep(t, bs[:4], []byte{0xff, 0xff, 0xff, 0xfe})
+			BE.Uint16.Marshal(0x0102, bs)
+			asserterror.EqualDeep(t, bs[:2], []byte{1, 2})
+			BE.Float64.Marshal(1.5, bs)
+			asserterror.EqualDeep(t, bs, binary.BigEndian.AppendUint64(nil,
+				math.Float64bits(1.5)))
+			BE.Float32.Marshal(-1.5, bs)
+			asserterror.EqualDeep(t, bs[:4], binary.BigEndian.AppendUint32(nil,
+				math.Float32bits(-1.5)))
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			bs := []byte{1}
+			_, n, err := BE.Uint64.Unmarshal(bs)
+			asserterror.Equal(t, n, 0)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Int32.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Int16.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Float64.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Float32.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, err = BE.Uint16.Skip(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestRaw_Duration(t *testing.T) {
+	t.Run("Duration serializer should succeed", func(t *testing.T) {
+		cases := []time.Duration{0, time.Nanosecond, -time.Hour,
+			math.MaxInt64, math.MinInt64}
+		test.Test(cases, Duration, t)
+		test.TestSkip(cases, Duration, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[time.Duration]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs = []byte{1, 2, 3}
+			)
+			test.TestUnmarshalOnly(bs, Duration, want, nil, t)
+		})
+}
+
+func TestRaw_Slice(t *testing.T) {
+	t.Run("Slice serializer should succeed for all numeric types",
+		func(t *testing.T) {
+			testSlice(t, []uint64{0, 1, 1 << 63, math.MaxUint64})
+			testSlice(t, []uint32{0, 1, math.MaxUint32})
+			testSlice(t, []uint16{0, 1, math.MaxUint16})
+			testSlice(t, []uint8{0, 1, math.MaxUint8})
+			testSlice(t, []uint{0, 1, math.MaxUint})
+			testSlice(t, []int64{0, -1, math.MinInt64, math.MaxInt64})
+			testSlice(t, []int32{0, -1, math.MinInt32, math.MaxInt32})
+			testSlice(t, []int16{0, -1, math.MinInt16, math.MaxInt16})
+			testSlice(t, []int8{0, -1, math.MinInt8, math.MaxInt8})
+			testSlice(t, []int{0, -1, math.MinInt, math.MaxInt})
+			testSlice(t, []float64{0, -1, math.MaxFloat64, math.Inf(-1)})
+			testSlice(t, []float32{0, -1, math.MaxFloat32, float32(math.Inf(1))})
+		})
+
+	t.Run("Slice serializer should produce the same encoding as the ord slice serializer",
+		func(t *testing.T) {
+			testSliceEncoding(t, []int32{0, -1, math.MinInt32, math.MaxInt32}, Int32)
+			testSliceEncoding(t, []uint16{0, 1, math.MaxUint16}, Uint16)
+			testSliceEncoding(t, []int8{0, -1, math.MinInt8}, Int8)
+			testSliceEncoding(t, []float64{0, -1, 3.14}, Float64)
+			testSliceEncoding(t, []float32{0, -1, 3.14}, Float32)
+		})
+
+	t.Run("Unmarshal should return ErrNegativeLength if meets a negative length",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1}
+				want = test.UnmarshalResult[[]int32]{
+					N:   1,
+					Err: com.ErrNegativeLength,
+				}
+				ser = NewSliceSer(slopts.WithLenSer[int32](varint.Int))
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{2, 1, 2, 3, 4, 5}
+				want = test.UnmarshalResult[[]int32]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewSliceSer[int32](), want, nil, t)
+		})
+
+	t.Run("Skip should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{2, 1, 2, 3, 4, 5}
+				want = test.SkipResult{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestSkipOnly(bs, NewSliceSer[int32](), want, nil, t)
+		})
+
+	t.Run("Valid slice serializer should succeed", func(t *testing.T) {
+		sls := [][]float64{{}, {0, -1, 3.14}}
+		test.Test(sls, NewValidSliceSer[float64](), t)
+		test.TestSkip(sls, NewValidSliceSer[float64](), t)
+	})
+
+	t.Run("If lenVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("lenVl error")
+				bs      = []byte{1, 1, 0}
+				lenVl   = cmock.NewValidator[int]().RegisterValidate(
+					func(v int) (err error) { return wantErr },
+				)
+				ser  = NewValidSliceSer(slopts.WithLenValidator[uint16](lenVl))
+				want = test.UnmarshalResult[[]uint16]{
+					N:   1,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{lenVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+
+	t.Run("If elemVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("elemVl error")
+				bs      = []byte{1, 1, 0}
+				elemVl  = cmock.NewValidator[uint16]().RegisterValidate(
+					func(v uint16) (err error) {
+						asserterror.Equal(t, v, 1)
+						return wantErr
+					},
+				)
+				ser  = NewValidSliceSer(slopts.WithElemValidator(elemVl))
+				want = test.UnmarshalResult[[]uint16]{
+					V:   []uint16{1},
+					N:   3,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{elemVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+}
+
+func testSlice[T Numeric](t *testing.T, v []T) {
+	ser := NewSliceSer[T]()
+	test.Test([][]T{{}, v}, ser, t)
+	test.TestSkip([][]T{{}, v}, ser, t)
+}
+
+func testSliceEncoding[T Numeric](t *testing.T, v []T,
+	elemSer mus.Serializer[T],
+) {
+	var (
+		ser    = NewSliceSer[T]()
+		ordSer = ord.NewSliceSer(elemSer)
+		bs     = make([]byte, ser.Size(v))
+		wantBs = make([]byte, ordSer.Size(v))
+		n      = ser.Marshal(v, bs)
+		wantN  = ordSer.Marshal(v, wantBs)
+	)
+	asserterror.Equal(t, n, wantN, "unexpected n")
+	asserterror.EqualDeep(t, bs, wantBs, "unexpected bs")
+}
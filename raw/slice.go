@@ -0,0 +1,229 @@
+package raw
+
+import (
+	"math"
+	"reflect"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// Numeric is a constraint that permits any fixed-width numeric type.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// NewSliceSer returns a new packed numeric slice serializer. The slice is
+// encoded as length + elements in the Raw encoding, so the whole body is
+// written and read in one go and can be skipped in O(1). To specify a length
+// or element validator, use NewValidSliceSer instead.
+func NewSliceSer[T Numeric](opts ...slopts.SetOption[T]) sliceSer[T] {
+	o := slopts.Options[T]{}
+	slopts.Apply(opts, &o)
+
+	return newSliceSer(o)
+}
+
+// NewValidSliceSer returns a new valid packed numeric slice serializer.
+func NewValidSliceSer[T Numeric](opts ...slopts.SetOption[T]) validSliceSer[T] {
+	o := slopts.Options[T]{}
+	slopts.Apply(opts, &o)
+
+	return validSliceSer[T]{
+		sliceSer: newSliceSer(o),
+		lenVl:    o.LenVl,
+		elemVl:   o.ElemVl,
+	}
+}
+
+func newSliceSer[T Numeric](o slopts.Options[T]) sliceSer[T] {
+	var lenSer mus.Serializer[int] = varint.PositiveInt
+	if o.LenSer != nil {
+		lenSer = o.LenSer
+	}
+	t := reflect.TypeFor[T]()
+	return sliceSer[T]{
+		lenSer:   lenSer,
+		elemSize: int(t.Size()),
+		float:    t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64,
+	}
+}
+
+type sliceSer[T Numeric] struct {
+	lenSer   mus.Serializer[int]
+	elemSize int
+	float    bool
+}
+
+// Marshal fills bs with an encoded (Raw) slice value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s sliceSer[T]) Marshal(v []T, bs []byte) (n int) {
+	n = s.lenSer.Marshal(len(v), bs)
+	l := n + len(v)*s.elemSize
+	if len(bs) < l {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	s.marshalElems(v, bs[n:l])
+	return l
+}
+
+// Unmarshal parses an encoded (Raw) slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a length
+// unmarshalling error.
+func (s sliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	return s.unmarshal(bs, nil, nil)
+}
+
+// Size returns the size of an encoded (Raw) slice value.
+func (s sliceSer[T]) Size(v []T) (size int) {
+	return s.lenSer.Size(len(v)) + len(v)*s.elemSize
+}
+
+// Skip skips an encoded (Raw) slice value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a length unmarshalling
+// error.
+func (s sliceSer[T]) Skip(bs []byte) (n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	n += length * s.elemSize
+	return
+}
+
+func (s sliceSer[T]) unmarshal(bs []byte, lenVl com.Validator[int],
+	elemVl com.Validator[T],
+) (v []T, n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	if lenVl != nil {
+		if err = lenVl.Validate(length); err != nil {
+			return
+		}
+	}
+	v = make([]T, length)
+	l := n + length*s.elemSize
+	s.unmarshalElems(bs[n:l], v)
+	n = l
+	if elemVl != nil {
+		for i := range v {
+			if err = elemVl.Validate(v[i]); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (s sliceSer[T]) unmarshalLength(bs []byte) (length, n int, err error) {
+	length, n, err = s.lenSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > (len(bs)-n)/s.elemSize {
+		err = mus.ErrTooSmallByteSlice
+	}
+	return
+}
+
+func (s sliceSer[T]) marshalElems(v []T, bs []byte) {
+	var n int
+	switch {
+	case s.float && s.elemSize == com.Num64RawSize:
+		for _, e := range v {
+			n += marshalInteger64(math.Float64bits(float64(e)), bs[n:])
+		}
+	case s.float:
+		for _, e := range v {
+			n += marshalInteger32(math.Float32bits(float32(e)), bs[n:])
+		}
+	case s.elemSize == com.Num64RawSize:
+		for _, e := range v {
+			n += marshalInteger64(uint64(e), bs[n:])
+		}
+	case s.elemSize == com.Num32RawSize:
+		for _, e := range v {
+			n += marshalInteger32(uint32(e), bs[n:])
+		}
+	case s.elemSize == com.Num16RawSize:
+		for _, e := range v {
+			n += marshalInteger16(uint16(e), bs[n:])
+		}
+	default:
+		for i, e := range v {
+			bs[i] = byte(e)
+		}
+	}
+}
+
+func (s sliceSer[T]) unmarshalElems(bs []byte, v []T) {
+	var n int
+	switch {
+	case s.float && s.elemSize == com.Num64RawSize:
+		for i := range v {
+			u, n1, _ := unmarshalInteger64[uint64](bs[n:])
+			v[i] = T(math.Float64frombits(u))
+			n += n1
+		}
+	case s.float:
+		for i := range v {
+			u, n1, _ := unmarshalInteger32[uint32](bs[n:])
+			v[i] = T(math.Float32frombits(u))
+			n += n1
+		}
+	case s.elemSize == com.Num64RawSize:
+		for i := range v {
+			u, n1, _ := unmarshalInteger64[uint64](bs[n:])
+			v[i] = T(u)
+			n += n1
+		}
+	case s.elemSize == com.Num32RawSize:
+		for i := range v {
+			u, n1, _ := unmarshalInteger32[uint32](bs[n:])
+			v[i] = T(u)
+			n += n1
+		}
+	case s.elemSize == com.Num16RawSize:
+		for i := range v {
+			u, n1, _ := unmarshalInteger16[uint16](bs[n:])
+			v[i] = T(u)
+			n += n1
+		}
+	default:
+		for i := range v {
+			v[i] = T(bs[i])
+		}
+	}
+}
+
+// valid -----------------------------------------------------------------------
+
+type validSliceSer[T Numeric] struct {
+	sliceSer[T]
+	lenVl  com.Validator[int]
+	elemVl com.Validator[T]
+}
+
+// Unmarshal parses an encoded (Raw) slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, a length
+// unmarshalling error, or a length/element validation error.
+func (s validSliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	return s.unmarshal(bs, s.lenVl, s.elemVl)
+}
@@ -0,0 +1,218 @@
+package raw
+
+import (
+	"sync"
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/ord"
+)
+
+var (
+	// TimeWithOffset is a time.Time serializer that encodes a value as a Unix
+	// timestamp in seconds + nanoseconds + zone offset in seconds. The
+	// deserialized value has a fixed zone with the same offset.
+	TimeWithOffset = timeWithOffsetSer{}
+	// TimeWithZone is a time.Time serializer that encodes a value as a Unix
+	// timestamp in seconds + nanoseconds + zone offset in seconds + zone name.
+	// The deserialized value is in the location loaded with time.LoadLocation,
+	// or, if it can't be loaded or has another offset at this instant, in a
+	// fixed zone with the same name and offset.
+	//
+	// A value in time.Local is encoded with the "Local" name rather than its
+	// IANA name, so it is deserialized in the local zone of the decoder, or,
+	// if it has another offset, in a fixed zone named "Local". To preserve
+	// the zone across machines, use a location loaded by name.
+	TimeWithZone = timeWithZoneSer{}
+)
+
+// timeWithOffsetSize is the size of an encoded time.Time value without a zone
+// name.
+const timeWithOffsetSize = com.Num64RawSize + com.Num32RawSize +
+	com.Num32RawSize
+
+// offset ----------------------------------------------------------------------
+
+type timeWithOffsetSer struct{}
+
+// Marshal fills bs with an encoded time.Time value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s timeWithOffsetSer) Marshal(v time.Time, bs []byte) (n int) {
+	_, offset := v.Zone()
+	return marshalTimeWithOffset(v, offset, bs)
+}
+
+// Unmarshal parses an encoded time.Time value from bs.
+//
+// In addition to the time.Time value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice or com.ErrWrongFormat, if nanoseconds are
+// out of range.
+func (s timeWithOffsetSer) Unmarshal(bs []byte) (v time.Time, n int,
+	err error,
+) {
+	v, offset, n, err := unmarshalTimeWithOffset(bs)
+	if err != nil {
+		return
+	}
+	v = v.In(time.FixedZone("", offset))
+	return
+}
+
+// Size returns the size of an encoded time.Time value.
+func (s timeWithOffsetSer) Size(v time.Time) (size int) {
+	return timeWithOffsetSize
+}
+
+// Skip skips an encoded time.Time value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s timeWithOffsetSer) Skip(bs []byte) (n int, err error) {
+	if len(bs) < timeWithOffsetSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	return timeWithOffsetSize, nil
+}
+
+// zone ------------------------------------------------------------------------
+
+type timeWithZoneSer struct{}
+
+// Marshal fills bs with an encoded time.Time value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s timeWithZoneSer) Marshal(v time.Time, bs []byte) (n int) {
+	_, offset := v.Zone()
+	n = marshalTimeWithOffset(v, offset, bs)
+	return n + ord.String.Marshal(v.Location().String(), bs[n:])
+}
+
+// Unmarshal parses an encoded time.Time value from bs.
+//
+// In addition to the time.Time value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrWrongFormat, if nanoseconds are out
+// of range, or a zone name unmarshalling error.
+func (s timeWithZoneSer) Unmarshal(bs []byte) (v time.Time, n int, err error) {
+	v, offset, n, err := unmarshalTimeWithOffset(bs)
+	if err != nil {
+		return
+	}
+	name, n1, err := ord.String.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	v = v.In(location(name, offset, v))
+	return
+}
+
+// Size returns the size of an encoded time.Time value.
+func (s timeWithZoneSer) Size(v time.Time) (size int) {
+	return timeWithOffsetSize + ord.String.Size(v.Location().String())
+}
+
+// Skip skips an encoded time.Time value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or a zone name skipping error.
+func (s timeWithZoneSer) Skip(bs []byte) (n int, err error) {
+	if n, err = TimeWithOffset.Skip(bs); err != nil {
+		return
+	}
+	n1, err := ord.String.Skip(bs[n:])
+	n += n1
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+func marshalTimeWithOffset(v time.Time, offset int, bs []byte) (n int) {
+	if len(bs) < timeWithOffsetSize {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	n = marshalInteger64(uint64(v.Unix()), bs)
+	n += marshalInteger32(uint32(v.Nanosecond()), bs[n:])
+	n += marshalInteger32(uint32(int32(offset)), bs[n:])
+	return
+}
+
+func unmarshalTimeWithOffset(bs []byte) (v time.Time, offset, n int,
+	err error,
+) {
+	if len(bs) < timeWithOffsetSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	var (
+		sec, n1, _  = unmarshalInteger64[uint64](bs)
+		nsec, n2, _ = unmarshalInteger32[uint32](bs[n1:])
+		off, n3, _  = unmarshalInteger32[uint32](bs[n1+n2:])
+	)
+	n = n1 + n2 + n3
+	if nsec > 999999999 {
+		err = com.ErrWrongFormat
+		return
+	}
+	v = time.Unix(int64(sec), int64(nsec))
+	offset = int(int32(off))
+	return
+}
+
+// maxUnknownLocations limits the number of cached names that can't be
+// loaded, the cache is cleared when the limit is reached.
+const maxUnknownLocations = 1024
+
+var (
+	// locations caches locations loaded by name.
+	locations sync.Map
+	// unknownLocations caches names that can't be loaded, so that the same
+	// name does not hit the file system on each Unmarshal.
+	unknownLocations   = make(map[string]struct{})
+	unknownLocationsMu sync.Mutex
+)
+
+func location(name string, offset int, t time.Time) *time.Location {
+	switch name {
+	case "":
+		return time.FixedZone("", offset)
+	case "UTC":
+		if offset == 0 {
+			return time.UTC
+		}
+	}
+	loc, ok := loadLocation(name)
+	if !ok {
+		return time.FixedZone(name, offset)
+	}
+	if _, off := t.In(loc).Zone(); off != offset {
+		return time.FixedZone(name, offset)
+	}
+	return loc
+}
+
+func loadLocation(name string) (loc *time.Location, ok bool) {
+	if l, ok := locations.Load(name); ok {
+		return l.(*time.Location), true
+	}
+	unknownLocationsMu.Lock()
+	_, unknown := unknownLocations[name]
+	unknownLocationsMu.Unlock()
+	if unknown {
+		return
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		unknownLocationsMu.Lock()
+		if len(unknownLocations) >= maxUnknownLocations {
+			clear(unknownLocations)
+		}
+		unknownLocations[name] = struct{}{}
+		unknownLocationsMu.Unlock()
+		return
+	}
+	l, _ := locations.LoadOrStore(name, loc)
+	return l.(*time.Location), true
+}
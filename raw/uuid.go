@@ -0,0 +1,48 @@
+package raw
+
+import "github.com/mus-format/mus-go"
+
+// UUIDSize is the size of an encoded UUID value.
+const UUIDSize = 16
+
+// UUID is a [16]byte serializer. Unlike array serializers, it writes exactly
+// 16 bytes, without a length.
+var UUID = uuidSer{}
+
+type uuidSer struct{}
+
+// Marshal fills bs with an encoded UUID value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s uuidSer) Marshal(v [16]byte, bs []byte) (n int) {
+	_ = bs[UUIDSize-1]
+	return copy(bs, v[:])
+}
+
+// Unmarshal parses an encoded UUID value from bs.
+//
+// In addition to the UUID value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s uuidSer) Unmarshal(bs []byte) (v [16]byte, n int, err error) {
+	if len(bs) < UUIDSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	return [16]byte(bs), UUIDSize, nil
+}
+
+// Size returns the size of an encoded UUID value.
+func (s uuidSer) Size(v [16]byte) (size int) {
+	return UUIDSize
+}
+
+// Skip skips an encoded UUID value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s uuidSer) Skip(bs []byte) (n int, err error) {
+	if len(bs) < UUIDSize {
+		return 0, mus.ErrTooSmallByteSlice
+	}
+	return UUIDSize, nil
+}
@@ -0,0 +1,70 @@
+package record
+
+import "github.com/mus-format/mus-go"
+
+// Field describes a single numbered field of the struct T.
+type Field[T any] interface {
+	// Num returns the field number.
+	Num() int
+
+	// IsPresent reports whether the field of v should be encoded.
+	IsPresent(v *T) bool
+
+	// Marshal fills bs with the encoded field of v.
+	Marshal(v *T, bs []byte) (n int)
+
+	// Unmarshal parses the encoded field from bs into v.
+	Unmarshal(v *T, bs []byte) (n int, err error)
+
+	// Size returns the size of the encoded field of v.
+	Size(v *T) (size int)
+}
+
+// NewField returns a new Field with the given number, which is encoded only
+// if its value differs from the zero value. get must return a pointer to the
+// field of the given struct.
+func NewField[T any, F comparable](num int, ser mus.Serializer[F],
+	get func(v *T) *F,
+) Field[T] {
+	return NewFieldFn(num, ser, get, func(f F) bool {
+		var zero F
+		return f != zero
+	})
+}
+
+// NewFieldFn returns a new Field with the given number, which is encoded only
+// if isPresent returns true. Can be used for fields of non-comparable types,
+// like slices or maps.
+func NewFieldFn[T, F any](num int, ser mus.Serializer[F], get func(v *T) *F,
+	isPresent func(f F) bool,
+) Field[T] {
+	return field[T, F]{num, ser, get, isPresent}
+}
+
+type field[T, F any] struct {
+	num       int
+	ser       mus.Serializer[F]
+	get       func(v *T) *F
+	isPresent func(f F) bool
+}
+
+func (f field[T, F]) Num() int {
+	return f.num
+}
+
+func (f field[T, F]) IsPresent(v *T) bool {
+	return f.isPresent(*f.get(v))
+}
+
+func (f field[T, F]) Marshal(v *T, bs []byte) (n int) {
+	return f.ser.Marshal(*f.get(v), bs)
+}
+
+func (f field[T, F]) Unmarshal(v *T, bs []byte) (n int, err error) {
+	*f.get(v), n, err = f.ser.Unmarshal(bs)
+	return
+}
+
+func (f field[T, F]) Size(v *T) (size int) {
+	return f.ser.Size(*f.get(v))
+}
@@ -0,0 +1,14 @@
+// Package record provides a schema-evolvable struct serializer. Each field is
+// encoded together with its number and length, so fields can be added or
+// removed without breaking older or newer readers.
+package record
+
+import (
+	"errors"
+
+	com "github.com/mus-format/common-go"
+)
+
+// ErrDuplicateFieldNum means that several fields have the same number.
+var ErrDuplicateFieldNum = errors.New(com.ErrorPrefix +
+	"duplicate field number")
@@ -0,0 +1,27 @@
+package record
+
+import (
+	"testing"
+
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzRecord_Ser(f *testing.F) {
+	ser := userV1Ser()
+	f.Add("", 0)
+	f.Add("bob", 30)
+	f.Fuzz(func(t *testing.T, name string, age int) {
+		v := userV1{Name: name, Age: age}
+		test.Test([]userV1{v}, ser, t)
+		test.TestSkip([]userV1{v}, ser, t)
+	})
+}
+
+func FuzzRecord_SerUnmarshal(f *testing.F) {
+	ser := userV1Ser()
+	f.Add([]byte{2, 1, 3, 2, 'a', 'b', 2, 1, 2})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
@@ -0,0 +1,191 @@
+package record
+
+import (
+	"strings"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	recopts "github.com/mus-format/mus-go/options/record"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/sm"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+type userV1 struct {
+	Name string
+	Age  int
+}
+
+type userV2 struct {
+	Name  string
+	Email string
+	Tags  []string
+}
+
+func userV1Ser(opts ...recopts.SetOption[userV1]) recordSer[userV1] {
+	return NewSer([]Field[userV1]{
+		NewField(1, ord.String, func(v *userV1) *string { return &v.Name }),
+		NewField(2, varint.Int, func(v *userV1) *int { return &v.Age }),
+	}, opts...)
+}
+
+func userV2Ser() recordSer[userV2] {
+	return NewSer([]Field[userV2]{
+		NewField(1, ord.String, func(v *userV2) *string { return &v.Name }),
+		NewField(3, ord.String, func(v *userV2) *string { return &v.Email }),
+		NewFieldFn(4, ord.NewSliceSer(ord.String),
+			func(v *userV2) *[]string { return &v.Tags },
+			func(f []string) bool { return len(f) > 0 }),
+	})
+}
+
+func TestRecord_Ser(t *testing.T) {
+	t.Run("Marshal should write count + (number, length, value) for present fields",
+		func(t *testing.T) {
+			var (
+				ser    = userV1Ser()
+				v      = userV1{Name: "ab", Age: 1}
+				wantBS = []byte{2, 1, 3, 2, 'a', 'b', 2, 1, 2}
+				bs     = make([]byte, ser.Size(v))
+				n      = ser.Marshal(v, bs)
+			)
+			asserterror.Equal(t, n, len(wantBS))
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Record serializer should work correctly", func(t *testing.T) {
+		ser := userV2Ser()
+		test.Test([]userV2{
+			{},
+			{Name: "alice"},
+			{Name: "bob", Email: "bob@example.com", Tags: []string{"a", "b"}},
+		}, ser, t)
+		test.TestSkip([]userV2{
+			{},
+			{Name: "bob", Email: "bob@example.com", Tags: []string{"a"}},
+		}, ser, t)
+	})
+
+	t.Run("Record serializer should support stateful field serializers",
+		func(t *testing.T) {
+			var (
+				strMap    = sm.NewStringMap()
+				revStrMap = sm.NewReverseStringMap()
+				smStr     = sm.NewStringSer(strMap, revStrMap)
+				ser       = sm.Wrap(strMap, revStrMap, NewSer([]Field[userV2]{
+					NewField(1, smStr, func(v *userV2) *string { return &v.Name }),
+					NewField(3, smStr, func(v *userV2) *string { return &v.Email }),
+				}))
+			)
+			test.Test([]userV2{
+				{Name: "hello", Email: "hello"},
+				{Name: "hello", Email: "world"},
+			}, ser, t)
+			test.TestSkip([]userV2{{Name: "hello", Email: "hello"}}, ser, t)
+		})
+
+	t.Run("Marshal should shift a field value if its length takes several bytes",
+		func(t *testing.T) {
+			test.Test([]userV1{{Name: strings.Repeat("a", 200), Age: 1}},
+				userV1Ser(), t)
+		})
+
+	t.Run("Old reader should skip unknown fields", func(t *testing.T) {
+		var (
+			v  = userV2{Name: "bob", Email: "bob@example.com", Tags: []string{"a"}}
+			bs = make([]byte, userV2Ser().Size(v))
+		)
+		userV2Ser().Marshal(v, bs)
+		u, n, err := userV1Ser().Unmarshal(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, len(bs))
+		asserterror.EqualDeep(t, u, userV1{Name: "bob"})
+	})
+
+	t.Run("New reader should leave missing fields zero", func(t *testing.T) {
+		var (
+			v  = userV1{Name: "bob", Age: 30}
+			bs = make([]byte, userV1Ser().Size(v))
+		)
+		userV1Ser().Marshal(v, bs)
+		u, n, err := userV2Ser().Unmarshal(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.Equal(t, n, len(bs))
+		asserterror.EqualDeep(t, u, userV2{Name: "bob"})
+	})
+
+	t.Run("Unknown fields should be passed to the sink", func(t *testing.T) {
+		type unknown struct {
+			num  int
+			data []byte
+		}
+		var (
+			v   = userV2{Name: "bob", Email: "e"}
+			bs  = make([]byte, userV2Ser().Size(v))
+			got []unknown
+			ser = userV1Ser(recopts.WithUnknownSink(
+				func(v *userV1, num int, data []byte) {
+					got = append(got, unknown{num, data})
+				}))
+		)
+		userV2Ser().Marshal(v, bs)
+		_, _, err := ser.Unmarshal(bs)
+		assertfatal.EqualError(t, err, nil)
+		asserterror.EqualDeep(t, got, []unknown{{3, []byte{1, 'e'}}})
+	})
+
+	t.Run("NewSer should panic with ErrDuplicateFieldNum if field numbers repeat",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), ErrDuplicateFieldNum)
+			}()
+			NewSer([]Field[userV1]{
+				NewField(1, ord.String, func(v *userV1) *string { return &v.Name }),
+				NewField(1, varint.Int, func(v *userV1) *int { return &v.Age }),
+			})
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if a field value does not take exactly its length",
+		func(t *testing.T) {
+			bs := []byte{1, 1, 4, 2, 'a', 'b', 'c'}
+			test.TestUnmarshalOnly(bs, userV1Ser(),
+				test.UnmarshalResult[userV1]{
+					V:   userV1{Name: "ab"},
+					N:   3,
+					Err: com.ErrWrongFormat,
+				}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return mus.ErrTooSmallByteSlice if a field length exceeds bs",
+		func(t *testing.T) {
+			bs := []byte{1, 5, 10, 1}
+			test.TestUnmarshalOnly(bs, userV1Ser(),
+				test.UnmarshalResult[userV1]{N: 3, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+			test.TestSkipOnly(bs, userV1Ser(),
+				test.SkipResult{N: 3, Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrNegativeLength if the count is negative",
+		func(t *testing.T) {
+			bs := make([]byte, varint.PositiveInt.Size(-1))
+			varint.PositiveInt.Marshal(-1, bs)
+			test.TestUnmarshalOnly(bs, userV1Ser(),
+				test.UnmarshalResult[userV1]{N: len(bs), Err: com.ErrNegativeLength},
+				nil, t)
+			test.TestSkipOnly(bs, userV1Ser(),
+				test.SkipResult{N: len(bs), Err: com.ErrNegativeLength}, nil, t)
+		})
+
+	t.Run("If field unmarshalling fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			bs := []byte{1, 1, 2, 3, 'a'}
+			test.TestUnmarshalOnly(bs, userV1Ser(),
+				test.UnmarshalResult[userV1]{N: 4, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+		})
+}
@@ -0,0 +1,171 @@
+package record
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	recopts "github.com/mus-format/mus-go/options/record"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewSer returns a new record serializer with the given fields. A record is
+// encoded as varint.PositiveInt number of present fields followed by
+// varint.PositiveInt field number + varint.PositiveInt length + value for each
+// of them.
+//
+// Fields with unknown numbers are skipped by their length, or passed to the
+// sink set with recopts.WithUnknownSink. Missing fields are left zero.
+//
+// Panics with ErrDuplicateFieldNum if several fields have the same number.
+func NewSer[T any](fields []Field[T],
+	opts ...recopts.SetOption[T],
+) recordSer[T] {
+	o := recopts.Options[T]{}
+	recopts.Apply(opts, &o)
+
+	m := make(map[int]Field[T], len(fields))
+	for i := range fields {
+		if _, pst := m[fields[i].Num()]; pst {
+			panic(ErrDuplicateFieldNum)
+		}
+		m[fields[i].Num()] = fields[i]
+	}
+	return recordSer[T]{fields, m, o.UnknownSink}
+}
+
+// recordSer implements the mus.Serializer interface for records.
+type recordSer[T any] struct {
+	fields []Field[T]
+	m      map[int]Field[T]
+	sink   recopts.UnknownSink[T]
+}
+
+// Marshal fills bs with an encoded record.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s recordSer[T]) Marshal(v T, bs []byte) (n int) {
+	n = varint.PositiveInt.Marshal(s.count(&v), bs)
+	for _, f := range s.fields {
+		if !f.IsPresent(&v) {
+			continue
+		}
+		n += varint.PositiveInt.Marshal(f.Num(), bs[n:])
+		n += ord.MarshalLengthPrefixed(func(bs []byte) int {
+			return f.Marshal(&v, bs)
+		}, bs[n:])
+	}
+	return
+}
+
+// Unmarshal parses an encoded record from bs.
+//
+// In addition to the record and the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrWrongFormat, if a
+// field value does not take exactly its length, a count/number/length
+// unmarshalling error, or a field unmarshalling error.
+func (s recordSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	count, n, err := unmarshalCount(bs)
+	if err != nil {
+		return
+	}
+	var num, length, n1 int
+	for range count {
+		num, length, n1, err = unmarshalHeader(bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		data := bs[n : n+length]
+		if f, pst := s.m[num]; pst {
+			if n1, err = f.Unmarshal(&v, data); err != nil {
+				n += n1
+				return
+			}
+			if n1 != length {
+				err = com.ErrWrongFormat
+				return
+			}
+		} else if s.sink != nil {
+			s.sink(&v, num, data)
+		}
+		n += length
+	}
+	return
+}
+
+// Size returns the size of an encoded record.
+func (s recordSer[T]) Size(v T) (size int) {
+	size = varint.PositiveInt.Size(s.count(&v))
+	var l int
+	for _, f := range s.fields {
+		if !f.IsPresent(&v) {
+			continue
+		}
+		l = f.Size(&v)
+		size += varint.PositiveInt.Size(f.Num()) + varint.PositiveInt.Size(l) + l
+	}
+	return
+}
+
+// Skip skips an encoded record.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a count/number/length
+// unmarshalling error.
+func (s recordSer[T]) Skip(bs []byte) (n int, err error) {
+	count, n, err := unmarshalCount(bs)
+	if err != nil {
+		return
+	}
+	var length, n1 int
+	for range count {
+		_, length, n1, err = unmarshalHeader(bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		n += length
+	}
+	return
+}
+
+func (s recordSer[T]) count(v *T) (count int) {
+	for _, f := range s.fields {
+		if f.IsPresent(v) {
+			count++
+		}
+	}
+	return
+}
+
+func unmarshalCount(bs []byte) (count, n int, err error) {
+	count, n, err = varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if count < 0 {
+		err = com.ErrNegativeLength
+	}
+	return
+}
+
+// unmarshalHeader also checks that bs is long enough to hold the field value.
+func unmarshalHeader(bs []byte) (num, length, n int, err error) {
+	num, n, err = varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	length, n1, err := varint.PositiveInt.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > len(bs)-n {
+		err = mus.ErrTooSmallByteSlice
+	}
+	return
+}
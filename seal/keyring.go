@@ -0,0 +1,53 @@
+package seal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+)
+
+// KeyProvider provides AEAD ciphers by key ID.
+type KeyProvider interface {
+	// CurrentKey returns the key used for sealing new values.
+	CurrentKey() (keyID int, aead cipher.AEAD)
+
+	// Key returns the key with the given ID and whether it is present.
+	Key(keyID int) (aead cipher.AEAD, pst bool)
+}
+
+// NewKeyring returns a new Keyring with the given keys, the key with the
+// currentID is used for sealing.
+//
+// Panics with ErrUnknownKey if there is no key with the currentID.
+func NewKeyring(currentID int, keys map[int]cipher.AEAD) Keyring {
+	if _, pst := keys[currentID]; !pst {
+		panic(ErrUnknownKey)
+	}
+	return Keyring{currentID, keys}
+}
+
+// Keyring implements the KeyProvider interface. Older keys can be kept in it
+// to unmarshal values sealed before a key rotation.
+type Keyring struct {
+	currentID int
+	keys      map[int]cipher.AEAD
+}
+
+// CurrentKey returns the key used for sealing new values.
+func (k Keyring) CurrentKey() (keyID int, aead cipher.AEAD) {
+	return k.currentID, k.keys[k.currentID]
+}
+
+// Key returns the key with the given ID and whether it is present.
+func (k Keyring) Key(keyID int) (aead cipher.AEAD, pst bool) {
+	aead, pst = k.keys[keyID]
+	return
+}
+
+// NewAESGCM returns a new AES-GCM cipher with the given 16, 24 or 32-byte key.
+func NewAESGCM(key []byte) (aead cipher.AEAD, err error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+	return cipher.NewGCM(block)
+}
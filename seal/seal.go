@@ -0,0 +1,18 @@
+// Package seal provides a serializer that encrypts and authenticates the
+// encoded value with an AEAD cipher, such as AES-GCM.
+package seal
+
+import (
+	"errors"
+
+	com "github.com/mus-format/common-go"
+)
+
+// ErrUnknownKey means that the key ID of the encoded value is not known to the
+// key provider.
+var ErrUnknownKey = errors.New(com.ErrorPrefix + "unknown key")
+
+// ErrAuthFailed means that the encoded value could not be authenticated: it
+// was corrupted, tampered with, or sealed with another key or additional
+// authenticated data.
+var ErrAuthFailed = errors.New(com.ErrorPrefix + "message authentication failed")
@@ -0,0 +1,39 @@
+package seal
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"testing"
+
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzSeal_Ser(f *testing.F) {
+	aead, err := NewAESGCM(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		f.Fatal(err)
+	}
+	ser := NewSer[string](ord.String,
+		NewKeyring(1, map[int]cipher.AEAD{1: aead}))
+	f.Add("")
+	f.Add("alice@example.com")
+	f.Fuzz(func(t *testing.T, v string) {
+		test.Test([]string{v}, ser, t)
+		test.TestSkip([]string{v}, ser, t)
+	})
+}
+
+func FuzzSeal_SerUnmarshal(f *testing.F) {
+	aead, err := NewAESGCM(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		f.Fatal(err)
+	}
+	ser := NewSer[string](ord.String,
+		NewKeyring(1, map[int]cipher.AEAD{1: aead}))
+	f.Add([]byte{1, 0, 0, 0})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
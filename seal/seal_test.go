@@ -0,0 +1,226 @@
+package seal
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"strings"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	sealopts "github.com/mus-format/mus-go/options/seal"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/sm"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/typed"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+func TestSeal_Ser(t *testing.T) {
+	t.Run("Sealed serializer should work correctly", func(t *testing.T) {
+		var (
+			keys = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+			ser  = NewSer[[]string](ord.NewSliceSer(ord.String), keys)
+		)
+		test.Test([][]string{{}, {"alice", "alice@example.com"}}, ser, t)
+		test.TestSkip([][]string{{"alice", "alice@example.com"}}, ser, t)
+	})
+
+	t.Run("Encoded value should not contain the plaintext", func(t *testing.T) {
+		var (
+			keys = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+			ser  = NewSer[string](ord.String, keys)
+			bs   = make([]byte, ser.Size("alice@example.com"))
+		)
+		ser.Marshal("alice@example.com", bs)
+		asserterror.Equal(t, bytes.Contains(bs, []byte("alice")), false,
+			"plaintext found")
+	})
+
+	t.Run("Each Marshal should use a new nonce", func(t *testing.T) {
+		var (
+			keys = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+			ser  = NewSer[string](ord.String, keys)
+			bs1  = make([]byte, ser.Size("abc"))
+			bs2  = make([]byte, ser.Size("abc"))
+		)
+		ser.Marshal("abc", bs1)
+		ser.Marshal("abc", bs2)
+		asserterror.Equal(t, bytes.Equal(bs1, bs2), false,
+			"same encoding for two Marshal calls")
+	})
+
+	t.Run("Values sealed with a previous key should be unmarshalled after rotation",
+		func(t *testing.T) {
+			var (
+				aead1 = newAEAD(t, 1)
+				aead2 = newAEAD(t, 2)
+				ser1  = NewSer[string](ord.String,
+					NewKeyring(1, map[int]cipher.AEAD{1: aead1}))
+				ser2 = NewSer[string](ord.String,
+					NewKeyring(2, map[int]cipher.AEAD{1: aead1, 2: aead2}))
+				bs = make([]byte, ser1.Size("abc"))
+			)
+			ser1.Marshal("abc", bs)
+			v, _, err := ser2.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			asserterror.Equal(t, v, "abc")
+			asserterror.Equal(t, bs[0], byte(1))
+		})
+
+	t.Run("Unmarshal and Skip should return ErrUnknownKey if the key ID is not known",
+		func(t *testing.T) {
+			var (
+				ser1 = NewSer[string](ord.String,
+					NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)}))
+				ser2 = NewSer[string](ord.String,
+					NewKeyring(2, map[int]cipher.AEAD{2: newAEAD(t, 2)}))
+				bs = make([]byte, ser1.Size("abc"))
+			)
+			ser1.Marshal("abc", bs)
+			test.TestUnmarshalOnly(bs, ser2,
+				test.UnmarshalResult[string]{N: 1, Err: ErrUnknownKey}, nil, t)
+			test.TestSkipOnly(bs, ser2, test.SkipResult{N: 1, Err: ErrUnknownKey},
+				nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrAuthFailed if data is tampered with",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)}))
+				bs = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[len(bs)-1] ^= 0x01
+			_, _, err := ser.Unmarshal(bs)
+			asserterror.EqualError(t, err, ErrAuthFailed)
+			_, err = ser.Skip(bs)
+			asserterror.EqualError(t, err, ErrAuthFailed)
+		})
+
+	t.Run("AAD should be authenticated", func(t *testing.T) {
+		var (
+			keys   = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+			dtmBs1 = make([]byte, typed.DTMSer.Size(1))
+			dtmBs2 = make([]byte, typed.DTMSer.Size(2))
+		)
+		typed.DTMSer.Marshal(1, dtmBs1)
+		typed.DTMSer.Marshal(2, dtmBs2)
+		var (
+			ser1 = NewSer[string](ord.String, keys, sealopts.WithAAD(dtmBs1))
+			ser2 = NewSer[string](ord.String, keys, sealopts.WithAAD(dtmBs2))
+			bs   = make([]byte, ser1.Size("abc"))
+		)
+		ser1.Marshal("abc", bs)
+		_, _, err := ser2.Unmarshal(bs)
+		asserterror.EqualError(t, err, ErrAuthFailed)
+		test.Test([]string{"abc"}, ser1, t)
+	})
+
+	t.Run("Key ID should be authenticated", func(t *testing.T) {
+		var (
+			aead = newAEAD(t, 1)
+			ser  = NewSer[string](ord.String,
+				NewKeyring(1, map[int]cipher.AEAD{1: aead, 2: aead}))
+			bs = make([]byte, ser.Size("abc"))
+		)
+		ser.Marshal("abc", bs)
+		bs[0] = 2
+		_, _, err := ser.Unmarshal(bs)
+		asserterror.EqualError(t, err, ErrAuthFailed)
+	})
+
+	t.Run("Sealed serializer should support stateful inner serializers",
+		func(t *testing.T) {
+			var (
+				strMap    = sm.NewStringMap()
+				revStrMap = sm.NewReverseStringMap()
+				keys      = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+				ser       = sm.Wrap(strMap, revStrMap, ord.NewSliceSer[string](
+					NewSer[string](sm.NewStringSer(strMap, revStrMap), keys)))
+			)
+			test.Test([][]string{{"a", "a"}, {"a", "b", "a"}}, ser, t)
+			test.TestSkip([][]string{{"a", "b", "a"}}, ser, t)
+		})
+
+	t.Run("Marshal should shift the inner encoding if the length takes several bytes",
+		func(t *testing.T) {
+			var (
+				keys = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+				ser  = NewSer[string](ord.String, keys)
+			)
+			test.Test([]string{strings.Repeat("a", 200)}, ser, t)
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if the inner encoding is not consumed entirely",
+		func(t *testing.T) {
+			var (
+				keys = NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)})
+				ser  = NewSer[string](ord.String, keys)
+				bs   = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			_, _, err := NewSer[byte](varint.Byte, keys).Unmarshal(bs)
+			asserterror.EqualError(t, err, com.ErrWrongFormat)
+		})
+
+	t.Run("Unmarshal should return mus.ErrTooSmallByteSlice if bs is too small",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)}))
+				bs = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			_, _, err := ser.Unmarshal(bs[:5])
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = ser.Unmarshal(bs[:len(bs)-1])
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+
+	t.Run("Marshal should panic if the nonce can't be generated",
+		func(t *testing.T) {
+			wantErr := errors.New("rand error")
+			defer func() {
+				asserterror.Equal[any](t, recover(), wantErr)
+			}()
+			ser := NewSer[string](ord.String,
+				NewKeyring(1, map[int]cipher.AEAD{1: newAEAD(t, 1)}),
+				sealopts.WithRand(errReader{wantErr}))
+			ser.Marshal("abc", make([]byte, ser.Size("abc")))
+		})
+}
+
+func TestSeal_Keyring(t *testing.T) {
+	t.Run("NewKeyring should panic with ErrUnknownKey if there is no current key",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), ErrUnknownKey)
+			}()
+			NewKeyring(1, map[int]cipher.AEAD{})
+		})
+
+	t.Run("NewAESGCM should return an error for a wrong key length",
+		func(t *testing.T) {
+			_, err := NewAESGCM([]byte{1, 2, 3})
+			asserterror.Equal(t, err != nil, true, "no error")
+		})
+}
+
+func newAEAD(t *testing.T, b byte) cipher.AEAD {
+	aead, err := NewAESGCM(bytes.Repeat([]byte{b}, 32))
+	assertfatal.EqualError(t, err, nil)
+	return aead
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,158 @@
+package seal
+
+import (
+	"crypto/rand"
+	"io"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	sealopts "github.com/mus-format/mus-go/options/seal"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewSer returns a new sealed serializer with the given inner serializer and
+// key provider. A value is encoded as varint.PositiveInt key ID + random nonce
+// + varint.PositiveInt ciphertext length + ciphertext, the ciphertext is the
+// sealed inner encoding.
+//
+// The encoded key ID is authenticated together with the additional data, for
+// example the encoded DTM of the value, that can be set with sealopts.WithAAD.
+func NewSer[T any](ser mus.Serializer[T], keys KeyProvider,
+	opts ...sealopts.SetOption,
+) sealSer[T] {
+	o := sealopts.Options{Rand: rand.Reader}
+	sealopts.Apply(opts, &o)
+
+	return sealSer[T]{ser, keys, o.AAD, o.Rand}
+}
+
+// sealSer implements the mus.Serializer interface and seals the encoded inner
+// value.
+type sealSer[T any] struct {
+	ser  mus.Serializer[T]
+	keys KeyProvider
+	aad  []byte
+	rand io.Reader
+}
+
+// Marshal fills bs with an encoded sealed value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs, or
+// if a nonce can't be generated.
+func (s sealSer[T]) Marshal(v T, bs []byte) (n int) {
+	keyID, aead := s.keys.CurrentKey()
+	n = varint.PositiveInt.Marshal(keyID, bs)
+	keyBs := bs[:n]
+	l := n + aead.NonceSize()
+	if len(bs) < l {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	nonce := bs[n:l]
+	if _, err := io.ReadFull(s.rand, nonce); err != nil {
+		panic(err)
+	}
+	n = l
+	// The inner value is marshalled first, after a one byte room for the
+	// ciphertext length, and shifted if the length takes more, so the inner
+	// Size is not called.
+	size := s.ser.Marshal(v, bs[n+1:])
+	l = size + aead.Overhead()
+	lenSize := varint.PositiveInt.Size(l)
+	if len(bs[n:]) < lenSize+l {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	if lenSize > 1 {
+		copy(bs[n+lenSize:n+lenSize+size], bs[n+1:n+1+size])
+	}
+	n += varint.PositiveInt.Marshal(l, bs[n:])
+	n += len(aead.Seal(bs[n:n], nonce, bs[n:n+size], s.additionalData(keyBs)))
+	return
+}
+
+// Unmarshal authenticates and parses an encoded sealed value from bs.
+//
+// In addition to the value and the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, ErrUnknownKey,
+// ErrAuthFailed, com.ErrWrongFormat, if the decrypted inner encoding is not
+// consumed entirely, a key ID/length unmarshalling error, or an inner
+// serializer unmarshalling error.
+func (s sealSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	plain, n, err := s.open(bs)
+	if err != nil {
+		return
+	}
+	v, n1, err := s.ser.Unmarshal(plain)
+	if err != nil {
+		return
+	}
+	if n1 != len(plain) {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+// Size returns the size of an encoded sealed value.
+func (s sealSer[T]) Size(v T) (size int) {
+	keyID, aead := s.keys.CurrentKey()
+	l := s.ser.Size(v) + aead.Overhead()
+	return varint.PositiveInt.Size(keyID) + aead.NonceSize() +
+		varint.PositiveInt.Size(l) + l
+}
+
+// Skip authenticates and skips an encoded sealed value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, ErrUnknownKey,
+// ErrAuthFailed, or a key ID/length unmarshalling error.
+func (s sealSer[T]) Skip(bs []byte) (n int, err error) {
+	_, n, err = s.open(bs)
+	return
+}
+
+func (s sealSer[T]) open(bs []byte) (plain []byte, n int, err error) {
+	keyID, n, err := varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	aead, pst := s.keys.Key(keyID)
+	if !pst {
+		err = ErrUnknownKey
+		return
+	}
+	keyLen := n
+	l := n + aead.NonceSize()
+	if len(bs) < l {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	nonce := bs[n:l]
+	n = l
+	length, n1, err := varint.PositiveInt.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if len(bs[n:]) < length {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	plain, err = aead.Open(nil, nonce, bs[n:n+length],
+		s.additionalData(bs[:keyLen]))
+	if err != nil {
+		err = ErrAuthFailed
+		return
+	}
+	n += length
+	return
+}
+
+// additionalData returns the encoded key ID followed by the AAD, so that the
+// key ID is authenticated as well.
+func (s sealSer[T]) additionalData(keyBs []byte) (ad []byte) {
+	ad = make([]byte, 0, len(keyBs)+len(s.aad))
+	return append(append(ad, keyBs...), s.aad...)
+}
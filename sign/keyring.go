@@ -0,0 +1,39 @@
+package sign
+
+// KeyProvider provides HMAC keys by key ID.
+type KeyProvider interface {
+	// CurrentKey returns the key used for signing new values.
+	CurrentKey() (keyID int, key []byte)
+
+	// Key returns the key with the given ID and whether it is present.
+	Key(keyID int) (key []byte, pst bool)
+}
+
+// NewKeyring returns a new Keyring with the given HMAC keys, the key with the
+// currentID is used for signing.
+//
+// Panics with ErrUnknownKey if there is no key with the currentID.
+func NewKeyring(currentID int, keys map[int][]byte) Keyring {
+	if _, pst := keys[currentID]; !pst {
+		panic(ErrUnknownKey)
+	}
+	return Keyring{currentID, keys}
+}
+
+// Keyring implements the KeyProvider interface. Older keys can be kept in it
+// to verify values signed before a key rotation.
+type Keyring struct {
+	currentID int
+	keys      map[int][]byte
+}
+
+// CurrentKey returns the key used for signing new values.
+func (k Keyring) CurrentKey() (keyID int, key []byte) {
+	return k.currentID, k.keys[k.currentID]
+}
+
+// Key returns the key with the given ID and whether it is present.
+func (k Keyring) Key(keyID int) (key []byte, pst bool) {
+	key, pst = k.keys[keyID]
+	return
+}
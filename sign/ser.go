@@ -0,0 +1,132 @@
+package sign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// MACSize is the size of an HMAC-SHA256 signature.
+const MACSize = sha256.Size
+
+// NewSer returns a new signed serializer with the given inner serializer and
+// key provider. A value is encoded as varint.PositiveInt key ID +
+// varint.PositiveInt length + inner encoding + HMAC-SHA256 over all of them.
+//
+// The signature is verified before the inner serializer is called, so
+// tampered data is never parsed by it.
+func NewSer[T any](ser mus.Serializer[T], keys KeyProvider) signSer[T] {
+	return signSer[T]{ser, keys}
+}
+
+// signSer implements the mus.Serializer interface and signs the encoded inner
+// value.
+type signSer[T any] struct {
+	ser  mus.Serializer[T]
+	keys KeyProvider
+}
+
+// Marshal fills bs with an encoded signed value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s signSer[T]) Marshal(v T, bs []byte) (n int) {
+	keyID, key := s.keys.CurrentKey()
+	n = varint.PositiveInt.Marshal(keyID, bs)
+	n += ord.MarshalLengthPrefixed(func(bs []byte) int {
+		return s.ser.Marshal(v, bs)
+	}, bs[n:])
+	if len(bs[n:]) < MACSize {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	n += copy(bs[n:], mac(key, bs[:n]))
+	return
+}
+
+// Unmarshal verifies the signature and parses an encoded signed value from bs.
+//
+// In addition to the value and the number of used bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, ErrUnknownKey,
+// ErrInvalidSignature, com.ErrWrongFormat, if the inner encoding is not
+// consumed entirely, a key ID/length unmarshalling error, or an inner
+// serializer unmarshalling error.
+func (s signSer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	dataStart, n, err := s.verify(bs)
+	if err != nil {
+		return
+	}
+	v, n1, err := s.ser.Unmarshal(bs[dataStart:n])
+	if err != nil {
+		n = dataStart + n1
+		return
+	}
+	if dataStart+n1 != n {
+		err = com.ErrWrongFormat
+		return
+	}
+	n += MACSize
+	return
+}
+
+// Size returns the size of an encoded signed value.
+func (s signSer[T]) Size(v T) (size int) {
+	keyID, _ := s.keys.CurrentKey()
+	size = s.ser.Size(v)
+	return varint.PositiveInt.Size(keyID) + varint.PositiveInt.Size(size) +
+		size + MACSize
+}
+
+// Skip verifies the signature and skips an encoded signed value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, ErrUnknownKey,
+// ErrInvalidSignature, or a key ID/length unmarshalling error.
+func (s signSer[T]) Skip(bs []byte) (n int, err error) {
+	_, n, err = s.verify(bs)
+	if err != nil {
+		return
+	}
+	n += MACSize
+	return
+}
+
+// verify returns the start and the end of the inner encoding.
+func (s signSer[T]) verify(bs []byte) (dataStart, n int, err error) {
+	keyID, n, err := varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	key, pst := s.keys.Key(keyID)
+	if !pst {
+		err = ErrUnknownKey
+		return
+	}
+	length, n1, err := varint.PositiveInt.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > len(bs[n:])-MACSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	dataStart = n
+	n += length
+	if !hmac.Equal(mac(key, bs[:n]), bs[n:n+MACSize]) {
+		err = ErrInvalidSignature
+	}
+	return
+}
+
+func mac(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,17 @@
+// Package sign provides a serializer that signs the encoded value with
+// HMAC-SHA256.
+package sign
+
+import (
+	"errors"
+
+	com "github.com/mus-format/common-go"
+)
+
+// ErrUnknownKey means that the key ID of the encoded value is not in the
+// keyring.
+var ErrUnknownKey = errors.New(com.ErrorPrefix + "unknown key")
+
+// ErrInvalidSignature means that the signature of the encoded value is not
+// valid.
+var ErrInvalidSignature = errors.New(com.ErrorPrefix + "invalid signature")
@@ -0,0 +1,29 @@
+package sign
+
+import (
+	"testing"
+
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzSign_Ser(f *testing.F) {
+	ser := NewSer[string](ord.String,
+		NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+	f.Add("")
+	f.Add("capability ticket")
+	f.Fuzz(func(t *testing.T, v string) {
+		test.Test([]string{v}, ser, t)
+		test.TestSkip([]string{v}, ser, t)
+	})
+}
+
+func FuzzSign_SerUnmarshal(f *testing.F) {
+	ser := NewSer[string](ord.String,
+		NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+	f.Add([]byte{1, 4, 3, 'a', 'b', 'c'})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
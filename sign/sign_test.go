@@ -0,0 +1,224 @@
+package sign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/sm"
+	"github.com/mus-format/mus-go/test"
+	"github.com/mus-format/mus-go/varint"
+	asserterror "github.com/ymz-ncnk/assert/error"
+	assertfatal "github.com/ymz-ncnk/assert/fatal"
+)
+
+func TestSign_Ser(t *testing.T) {
+	t.Run("Marshal should append HMAC-SHA256 over key ID + length + inner encoding",
+		func(t *testing.T) {
+			var (
+				key = []byte("secret")
+				ser = NewSer[string](ord.String,
+					NewKeyring(5, map[int][]byte{5: key}))
+				bs   = make([]byte, ser.Size("abc"))
+				n    = ser.Marshal("abc", bs)
+				data = []byte{5, 4, 3, 'a', 'b', 'c'}
+				h    = hmac.New(sha256.New, key)
+			)
+			h.Write(data)
+			wantBS := h.Sum(data)
+			asserterror.Equal(t, n, len(wantBS))
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Signed serializer should work correctly", func(t *testing.T) {
+		ser := NewSer[[]int](ord.NewSliceSer(varint.Int),
+			NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+		test.Test([][]int{{}, {1, 2, 3}}, ser, t)
+		test.TestSkip([][]int{{}, {1, 2, 3}}, ser, t)
+	})
+
+	t.Run("Values signed with a previous key should be verified after rotation",
+		func(t *testing.T) {
+			var (
+				ser1 = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("key-1")}))
+				ser2 = NewSer[string](ord.String,
+					NewKeyring(2, map[int][]byte{
+						1: []byte("key-1"),
+						2: []byte("key-2"),
+					}))
+				bs = make([]byte, ser1.Size("abc"))
+			)
+			ser1.Marshal("abc", bs)
+			v, _, err := ser2.Unmarshal(bs)
+			assertfatal.EqualError(t, err, nil)
+			asserterror.Equal(t, v, "abc")
+		})
+
+	t.Run("Unmarshal and Skip should return ErrUnknownKey if the key ID is not in the keyring",
+		func(t *testing.T) {
+			var (
+				ser1 = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("key-1")}))
+				ser2 = NewSer[string](ord.String,
+					NewKeyring(2, map[int][]byte{2: []byte("key-2")}))
+				bs = make([]byte, ser1.Size("abc"))
+			)
+			ser1.Marshal("abc", bs)
+			test.TestUnmarshalOnly(bs, ser2,
+				test.UnmarshalResult[string]{N: 1, Err: ErrUnknownKey}, nil, t)
+			test.TestSkipOnly(bs, ser2, test.SkipResult{N: 1, Err: ErrUnknownKey},
+				nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrInvalidSignature if data is tampered with",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+				bs = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[3] = 'x'
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 6, Err: ErrInvalidSignature}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 6, Err: ErrInvalidSignature}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return ErrInvalidSignature if the length is tampered with",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+				bs = make([]byte, ser.Size("abc"))
+			)
+			ser.Marshal("abc", bs)
+			bs[1] ^= 0x07
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 5, Err: ErrInvalidSignature}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 5, Err: ErrInvalidSignature}, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrInvalidSignature if the key is wrong",
+		func(t *testing.T) {
+			var (
+				ser1 = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("key-1")}))
+				ser2 = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("another")}))
+				bs = make([]byte, ser1.Size("abc"))
+			)
+			ser1.Marshal("abc", bs)
+			_, _, err := ser2.Unmarshal(bs)
+			asserterror.EqualError(t, err, ErrInvalidSignature)
+		})
+
+	t.Run("Unmarshal and Skip should return mus.ErrTooSmallByteSlice if the signature is missing",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+				bs = []byte{1, 4, 3, 'a', 'b', 'c', 0}
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 2, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 2, Err: mus.ErrTooSmallByteSlice}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrNegativeLength if the length is negative",
+		func(t *testing.T) {
+			var (
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+				bs = make([]byte, 1+varint.PositiveInt.Size(-1))
+				n  = 1 + varint.PositiveInt.Marshal(-1, bs[1:])
+			)
+			bs[0] = 1
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: n, Err: com.ErrNegativeLength},
+				nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: n, Err: com.ErrNegativeLength}, nil, t)
+		})
+
+	t.Run("If inner serializer Unmarshal fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				key = []byte("secret")
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: key}))
+				bs = signed(key, []byte{1, 2, 3, 'a'})
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{N: 3, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if the inner encoding is not consumed entirely",
+		func(t *testing.T) {
+			var (
+				key = []byte("secret")
+				ser = NewSer[string](ord.String,
+					NewKeyring(1, map[int][]byte{1: key}))
+				bs = signed(key, []byte{1, 5, 3, 'a', 'b', 'c', 'd'})
+			)
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[string]{V: "abc", N: 7,
+					Err: com.ErrWrongFormat}, nil, t)
+		})
+
+	t.Run("Signed serializer should support stateful inner serializers",
+		func(t *testing.T) {
+			var (
+				strMap    = sm.NewStringMap()
+				revStrMap = sm.NewReverseStringMap()
+				keys      = NewKeyring(1, map[int][]byte{1: []byte("secret")})
+				ser       = sm.Wrap(strMap, revStrMap, NewSer[[]string](
+					ord.NewSliceSer[string](sm.NewStringSer(strMap, revStrMap)),
+					keys))
+			)
+			test.Test([][]string{{"a", "a", "b"}}, ser, t)
+			test.TestSkip([][]string{{"a", "a", "b"}}, ser, t)
+		})
+
+	t.Run("Marshal should shift the inner encoding if the length takes several bytes",
+		func(t *testing.T) {
+			ser := NewSer[string](ord.String,
+				NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+			test.Test([]string{strings.Repeat("a", 200)}, ser, t)
+		})
+
+	t.Run("Marshal should panic with mus.ErrTooSmallByteSlice if there is no space for the signature",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), mus.ErrTooSmallByteSlice)
+			}()
+			ser := NewSer[string](ord.String,
+				NewKeyring(1, map[int][]byte{1: []byte("secret")}))
+			ser.Marshal("abc", make([]byte, 10))
+		})
+}
+
+func signed(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(data)
+}
+
+func TestSign_Keyring(t *testing.T) {
+	t.Run("NewKeyring should panic with ErrUnknownKey if there is no current key",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), ErrUnknownKey)
+			}()
+			NewKeyring(1, map[int][]byte{})
+		})
+}
@@ -0,0 +1,4 @@
+// Package sm (string mapping) provides a string serializer that writes each
+// distinct string only once per top-level value and back-references it
+// afterwards.
+package sm
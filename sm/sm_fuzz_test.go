@@ -0,0 +1,65 @@
+package sm
+
+import (
+	"errors"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzSM_String(f *testing.F) {
+	f.Add("a", "b", 3)
+	f.Add("", "", 1)
+	f.Add("host-1", "host-2", 10)
+
+	f.Fuzz(func(t *testing.T, s1, s2 string, count int) {
+		if count < 0 || count > 20 {
+			return
+		}
+		var (
+			strMap    = NewStringMap()
+			revStrMap = NewReverseStringMap()
+			ser       = Wrap(strMap, revStrMap,
+				ord.NewSliceSer[string](NewStringSer(strMap, revStrMap)))
+			v = make([]string, count)
+		)
+		for i := range v {
+			if i%3 == 0 {
+				v[i] = s2
+			} else {
+				v[i] = s1
+			}
+		}
+		test.Test([][]string{v}, ser, t)
+		test.TestSkip([][]string{v}, ser, t)
+	})
+}
+
+func FuzzSM_StringUnmarshal(f *testing.F) {
+	f.Add([]byte{2, 0, 1, 'a', 0})
+	f.Add([]byte{1, 1})
+	f.Add([]byte{})
+
+	var (
+		strMap    = NewStringMap()
+		revStrMap = NewReverseStringMap()
+		// We use Valid serializer to avoid OOM during fuzzing.
+		ser = Wrap(strMap, revStrMap,
+			ord.NewValidSliceSer[string](NewStringSer(strMap, revStrMap),
+				slopts.WithLenValidator[string](
+					com.ValidatorFn[int](func(v int) error {
+						if v > 100 {
+							return errors.New("too large length")
+						}
+						return nil
+					}),
+				)))
+	)
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
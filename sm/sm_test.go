@@ -0,0 +1,139 @@
+package sm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	stropts "github.com/mus-format/mus-go/options/string"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
+	asserterror "github.com/ymz-ncnk/assert/error"
+)
+
+func TestSM_String(t *testing.T) {
+	t.Run("Marshal should write a string only on its first occurrence",
+		func(t *testing.T) {
+			var (
+				strMap    = NewStringMap()
+				revStrMap = NewReverseStringMap()
+				ser       = Wrap(strMap, revStrMap,
+					ord.NewSliceSer[string](NewStringSer(strMap, revStrMap)))
+				v      = []string{"ab", "c", "ab", "ab", "c"}
+				wantBS = []byte{5, 0, 2, 'a', 'b', 1, 1, 'c', 0, 0, 1}
+				size   = ser.Size(v)
+				bs     = make([]byte, size)
+				n      = ser.Marshal(v, bs)
+			)
+			asserterror.Equal(t, n, len(wantBS),
+				fmt.Sprintf("unexpected n, want '%v' actual '%v'", len(wantBS), n))
+			asserterror.EqualDeep(t, bs, wantBS,
+				fmt.Sprintf("unexpected bs, want '%v' actual '%v'", wantBS, bs))
+		})
+
+	t.Run("Wrapped serializer should work correctly", func(t *testing.T) {
+		var (
+			strMap    = NewStringMap()
+			revStrMap = NewReverseStringMap()
+			ser       = Wrap(strMap, revStrMap,
+				ord.NewSliceSer[string](NewStringSer(strMap, revStrMap)))
+		)
+		test.Test([][]string{
+			{},
+			{""},
+			{"host-1", "host-2", "host-1", "", "host-2", ""},
+			{"host-2", "host-2"},
+		}, ser, t)
+		test.TestSkip([][]string{
+			{"host-1", "host-2", "host-1", "", "host-2", ""},
+			{"host-2", "host-2"},
+		}, ser, t)
+	})
+
+	t.Run("Maps should be reset after each top-level value",
+		func(t *testing.T) {
+			var (
+				strMap    = NewStringMap()
+				revStrMap = NewReverseStringMap()
+				ser       = Wrap(strMap, revStrMap,
+					ord.NewSliceSer[string](NewStringSer(strMap, revStrMap)))
+				v  = []string{"a", "a"}
+				bs = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			ser.Unmarshal(bs)
+			asserterror.Equal(t, strMap.Len(), 0, "string map was not reset")
+			asserterror.Equal(t, revStrMap.Len(), 0,
+				"reverse string map was not reset")
+		})
+
+	t.Run("Unmarshal should return com.ErrWrongFormat if the ID is neither known nor the next one",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[string]{
+					V:   "",
+					N:   1,
+					Err: com.ErrWrongFormat,
+				}
+				bs  = []byte{1, 1, 'a'}
+				ser = NewStringSer(NewStringMap(), NewReverseStringMap())
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Skip should return com.ErrWrongFormat if the ID is neither known nor the next one",
+		func(t *testing.T) {
+			var (
+				want = test.SkipResult{N: 1, Err: com.ErrWrongFormat}
+				bs   = []byte{2}
+				ser  = NewStringSer(NewStringMap(), NewReverseStringMap())
+			)
+			test.TestSkipOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("If unmarshalling ID fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[string]{
+					V:   "",
+					N:   0,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				ser = NewStringSer(NewStringMap(), NewReverseStringMap())
+			)
+			test.TestUnmarshalOnly([]byte{}, ser, want, nil, t)
+		})
+
+	t.Run("If unmarshalling string fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[string]{
+					V:   "",
+					N:   2,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				bs  = []byte{0, 3, 'a'}
+				ser = NewStringSer(NewStringMap(), NewReverseStringMap())
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Length validator should be applied to new strings",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("too long")
+				vl      = com.ValidatorFn[int](func(l int) (err error) {
+					if l > 2 {
+						err = wantErr
+					}
+					return
+				})
+				ser = NewStringSer(NewStringMap(), NewReverseStringMap(),
+					stropts.WithLenValidator(vl))
+			)
+			_, _, err := ser.Unmarshal([]byte{0, 3, 'a', 'b', 'c'})
+			asserterror.EqualError(t, err, wantErr)
+		})
+}
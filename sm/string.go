@@ -0,0 +1,100 @@
+package sm
+
+import (
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	stropts "github.com/mus-format/mus-go/options/string"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewStringSer returns a new string serializer with the given string map and
+// reverse string map. Options configure the serializer of strings that occur
+// for the first time; the length validator is applied to them as well.
+//
+// Each string is encoded as varint.PositiveInt ID, followed by the string
+// itself only on its first occurrence. Since the serializer is stateful, a
+// top-level serializer that uses it should be wrapped with Wrap.
+func NewStringSer(strMap *StringMap, revStrMap *ReverseStringMap,
+	opts ...stropts.SetOption,
+) stringSer {
+	return stringSer{strMap, revStrMap, ord.NewValidStringSer(opts...)}
+}
+
+type stringSer struct {
+	strMap    *StringMap
+	revStrMap *ReverseStringMap
+	strSer    mus.Serializer[string]
+}
+
+// Marshal fills bs with an encoded string.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s stringSer) Marshal(v string, bs []byte) (n int) {
+	id, newOne := mapstr(v, s.strMap)
+	n = varint.PositiveInt.Marshal(id, bs)
+	if newOne {
+		n += s.strSer.Marshal(v, bs[n:])
+	}
+	return
+}
+
+// Unmarshal parses an encoded string from bs.
+//
+// In addition to the string and the number of used bytes, it may also return
+// com.ErrWrongFormat, if the ID is neither known nor the next one, an ID
+// unmarshalling error, or a string unmarshalling error.
+func (s stringSer) Unmarshal(bs []byte) (v string, n int, err error) {
+	id, n, err := varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	v, pst := s.revStrMap.Get(id)
+	if pst {
+		return
+	}
+	if id != s.revStrMap.Len() {
+		err = com.ErrWrongFormat
+		return
+	}
+	var n1 int
+	v, n1, err = s.strSer.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	s.revStrMap.Put(v)
+	return
+}
+
+// Size returns the size of an encoded string.
+func (s stringSer) Size(v string) (size int) {
+	id, newOne := mapstr(v, s.strMap)
+	size = varint.PositiveInt.Size(id)
+	if newOne {
+		size += s.strSer.Size(v)
+	}
+	return
+}
+
+// Skip skips an encoded string.
+//
+// A string that occurs for the first time is still unmarshalled, so that
+// the following back-references to it can be resolved.
+//
+// In addition to the number of skipped bytes, it may also return
+// com.ErrWrongFormat, an ID unmarshalling error, or a string unmarshalling
+// error.
+func (s stringSer) Skip(bs []byte) (n int, err error) {
+	_, n, err = s.Unmarshal(bs)
+	return
+}
+
+func mapstr(str string, strMap *StringMap) (id int, newOne bool) {
+	id, pst := strMap.Get(str)
+	if !pst {
+		id = strMap.Put(str)
+		newOne = true
+	}
+	return
+}
@@ -0,0 +1,59 @@
+package sm
+
+// NewStringMap returns a new StringMap.
+func NewStringMap() *StringMap {
+	return &StringMap{m: make(map[string]int)}
+}
+
+// StringMap assigns sequential IDs to strings during marshalling.
+type StringMap struct {
+	m map[string]int
+}
+
+// Put assigns the next ID to str and returns it.
+func (m *StringMap) Put(str string) (id int) {
+	id = len(m.m)
+	m.m[str] = id
+	return
+}
+
+// Get returns the ID of str and whether it is present.
+func (m *StringMap) Get(str string) (id int, pst bool) {
+	id, pst = m.m[str]
+	return
+}
+
+// Len returns the number of strings in the map.
+func (m *StringMap) Len() int {
+	return len(m.m)
+}
+
+// NewReverseStringMap returns a new ReverseStringMap.
+func NewReverseStringMap() *ReverseStringMap {
+	return &ReverseStringMap{}
+}
+
+// ReverseStringMap resolves IDs to strings during unmarshalling.
+type ReverseStringMap struct {
+	s []string
+}
+
+// Put adds str with the next ID and returns it.
+func (m *ReverseStringMap) Put(str string) (id int) {
+	id = len(m.s)
+	m.s = append(m.s, str)
+	return
+}
+
+// Get returns the string with the given ID and whether it is present.
+func (m *ReverseStringMap) Get(id int) (str string, pst bool) {
+	if id < 0 || id >= len(m.s) {
+		return
+	}
+	return m.s[id], true
+}
+
+// Len returns the number of strings in the map.
+func (m *ReverseStringMap) Len() int {
+	return len(m.s)
+}
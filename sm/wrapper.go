@@ -0,0 +1,61 @@
+package sm
+
+import (
+	"github.com/mus-format/mus-go"
+)
+
+// Wrap function wraps the serializer that uses one or more sm string
+// serializers (all created with the same string and reverse string maps), so
+// it can be used like a regular serializer. The maps are reset after each
+// top-level value.
+func Wrap[T any](strMap *StringMap, revStrMap *ReverseStringMap,
+	ser mus.Serializer[T],
+) wrapper[T] {
+	return wrapper[T]{strMap, revStrMap, ser}
+}
+
+type wrapper[T any] struct {
+	strMap    *StringMap
+	revStrMap *ReverseStringMap
+	ser       mus.Serializer[T]
+}
+
+// Marshal fills bs with an encoded value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (w wrapper[T]) Marshal(v T, bs []byte) (n int) {
+	defer func() {
+		*w.strMap = *NewStringMap()
+	}()
+	return w.ser.Marshal(v, bs)
+}
+
+// Unmarshal parses an encoded value from bs.
+//
+// In addition to the value and the number of used bytes, it may also return
+// an inner serializer unmarshalling error.
+func (w wrapper[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	defer func() {
+		*w.revStrMap = *NewReverseStringMap()
+	}()
+	return w.ser.Unmarshal(bs)
+}
+
+// Size returns the size of an encoded value.
+func (w wrapper[T]) Size(v T) (size int) {
+	defer func() {
+		*w.strMap = *NewStringMap()
+	}()
+	return w.ser.Size(v)
+}
+
+// Skip skips an encoded value.
+//
+// In addition to the number of skipped bytes, it may also return an inner
+// serializer skipping error.
+func (w wrapper[T]) Skip(bs []byte) (n int, err error) {
+	defer func() {
+		*w.revStrMap = *NewReverseStringMap()
+	}()
+	return w.ser.Skip(bs)
+}
@@ -56,6 +56,20 @@ func Test[T any](cases []T, ser mus.Serializer[T], t *testing.T) {
 				continue
 			}
 		}
+		if c128, ok := any(v).(complex128); ok {
+			want := any(cases[i]).(complex128)
+			if math.Float64bits(real(c128)) == math.Float64bits(real(want)) &&
+				math.Float64bits(imag(c128)) == math.Float64bits(imag(want)) {
+				continue
+			}
+		}
+		if c64, ok := any(v).(complex64); ok {
+			want := any(cases[i]).(complex64)
+			if math.Float32bits(real(c64)) == math.Float32bits(real(want)) &&
+				math.Float32bits(imag(c64)) == math.Float32bits(imag(want)) {
+				continue
+			}
+		}
 		asserterror.EqualDeep(t, v, cases[i],
 			fmt.Sprintf("case '%v', unexpected v, want '%v' actual '%v'", i, cases[i], v))
 	}
@@ -0,0 +1,346 @@
+package typed
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// ErrUnsupportedType means that the type of an any value is neither a
+// built-in one nor registered with NewAnySer.
+var ErrUnsupportedType = errors.New(com.ErrorPrefix + "unsupported type")
+
+// ErrDuplicateDTM means that several serializers registered with NewAnySer
+// have the same DTM or type.
+var ErrDuplicateDTM = errors.New(com.ErrorPrefix + "duplicate DTM")
+
+// Built-in tags of the any serializer, must not be changed.
+const (
+	anyNilTag = iota
+	anyBoolTag
+	anyIntTag
+	anyInt8Tag
+	anyInt16Tag
+	anyInt32Tag
+	anyInt64Tag
+	anyUintTag
+	anyUint8Tag
+	anyUint16Tag
+	anyUint32Tag
+	anyUint64Tag
+	anyFloat32Tag
+	anyFloat64Tag
+	anyStringTag
+	anyByteSliceTag
+	anyTimeTag
+	// anyTypedTag is followed by DTM + data of a registered type.
+	anyTypedTag
+)
+
+// AnyTypeSer is a serializer that can be registered with NewAnySer. It is
+// implemented by Ser.
+type AnyTypeSer interface {
+	DTM() com.DTM
+	anyType() reflect.Type
+	marshalAny(v any, bs []byte) (n int)
+	unmarshalDataAny(bs []byte) (v any, n int, err error)
+	sizeAny(v any) (size int)
+	SkipData(bs []byte) (n int, err error)
+}
+
+// NewAnySer returns a new serializer of any values. A value is encoded as
+// varint.PositiveInt tag + value.
+//
+// The following types have built-in tags: nil, bool, int, int8, int16, int32,
+// int64, uint, uint8, uint16, uint32, uint64, float32, float64 (all in the
+// Varint encoding), string, []byte (as in the ord package) and time.Time (as
+// varint.Time). Other types, including named types based on the built-in ones,
+// can be registered with typed serializers, they are encoded as a special tag
+// + DTM + data.
+//
+// Panics with ErrDuplicateDTM if several serializers have the same DTM or
+// type.
+func NewAnySer(sers ...AnyTypeSer) anySer {
+	s := anySer{
+		types: make(map[reflect.Type]AnyTypeSer, len(sers)),
+		dtms:  make(map[com.DTM]AnyTypeSer, len(sers)),
+	}
+	for _, ser := range sers {
+		if _, pst := s.types[ser.anyType()]; pst {
+			panic(ErrDuplicateDTM)
+		}
+		if _, pst := s.dtms[ser.DTM()]; pst {
+			panic(ErrDuplicateDTM)
+		}
+		s.types[ser.anyType()] = ser
+		s.dtms[ser.DTM()] = ser
+	}
+	return s
+}
+
+type anySer struct {
+	types map[reflect.Type]AnyTypeSer
+	dtms  map[com.DTM]AnyTypeSer
+}
+
+// Marshal fills bs with an encoded any value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs or
+// with ErrUnsupportedType if the type of the value is not supported.
+func (s anySer) Marshal(v any, bs []byte) (n int) {
+	switch t := v.(type) {
+	case nil:
+		return varint.PositiveInt.Marshal(anyNilTag, bs)
+	case bool:
+		n = varint.PositiveInt.Marshal(anyBoolTag, bs)
+		return n + ord.Bool.Marshal(t, bs[n:])
+	case int:
+		n = varint.PositiveInt.Marshal(anyIntTag, bs)
+		return n + varint.Int.Marshal(t, bs[n:])
+	case int8:
+		n = varint.PositiveInt.Marshal(anyInt8Tag, bs)
+		return n + varint.Int8.Marshal(t, bs[n:])
+	case int16:
+		n = varint.PositiveInt.Marshal(anyInt16Tag, bs)
+		return n + varint.Int16.Marshal(t, bs[n:])
+	case int32:
+		n = varint.PositiveInt.Marshal(anyInt32Tag, bs)
+		return n + varint.Int32.Marshal(t, bs[n:])
+	case int64:
+		n = varint.PositiveInt.Marshal(anyInt64Tag, bs)
+		return n + varint.Int64.Marshal(t, bs[n:])
+	case uint:
+		n = varint.PositiveInt.Marshal(anyUintTag, bs)
+		return n + varint.Uint.Marshal(t, bs[n:])
+	case uint8:
+		n = varint.PositiveInt.Marshal(anyUint8Tag, bs)
+		return n + varint.Uint8.Marshal(t, bs[n:])
+	case uint16:
+		n = varint.PositiveInt.Marshal(anyUint16Tag, bs)
+		return n + varint.Uint16.Marshal(t, bs[n:])
+	case uint32:
+		n = varint.PositiveInt.Marshal(anyUint32Tag, bs)
+		return n + varint.Uint32.Marshal(t, bs[n:])
+	case uint64:
+		n = varint.PositiveInt.Marshal(anyUint64Tag, bs)
+		return n + varint.Uint64.Marshal(t, bs[n:])
+	case float32:
+		n = varint.PositiveInt.Marshal(anyFloat32Tag, bs)
+		return n + varint.Float32.Marshal(t, bs[n:])
+	case float64:
+		n = varint.PositiveInt.Marshal(anyFloat64Tag, bs)
+		return n + varint.Float64.Marshal(t, bs[n:])
+	case string:
+		n = varint.PositiveInt.Marshal(anyStringTag, bs)
+		return n + ord.String.Marshal(t, bs[n:])
+	case []byte:
+		n = varint.PositiveInt.Marshal(anyByteSliceTag, bs)
+		return n + ord.ByteSlice.Marshal(t, bs[n:])
+	case time.Time:
+		n = varint.PositiveInt.Marshal(anyTimeTag, bs)
+		return n + varint.Time.Marshal(t, bs[n:])
+	}
+	n = varint.PositiveInt.Marshal(anyTypedTag, bs)
+	return n + s.typeSer(v).marshalAny(v, bs[n:])
+}
+
+// Unmarshal parses an encoded any value from bs.
+//
+// In addition to the any value and the number of used bytes, it may also
+// return com.ErrWrongFormat, if the tag is unknown, com.UnexpectedDTMError, if
+// the DTM is not registered, or a tag/DTM/value unmarshalling error.
+func (s anySer) Unmarshal(bs []byte) (v any, n int, err error) {
+	tag, n, err := varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	var n1 int
+	switch tag {
+	case anyNilTag:
+		return
+	case anyBoolTag:
+		v, n1, err = ord.Bool.Unmarshal(bs[n:])
+	case anyIntTag:
+		v, n1, err = varint.Int.Unmarshal(bs[n:])
+	case anyInt8Tag:
+		v, n1, err = varint.Int8.Unmarshal(bs[n:])
+	case anyInt16Tag:
+		v, n1, err = varint.Int16.Unmarshal(bs[n:])
+	case anyInt32Tag:
+		v, n1, err = varint.Int32.Unmarshal(bs[n:])
+	case anyInt64Tag:
+		v, n1, err = varint.Int64.Unmarshal(bs[n:])
+	case anyUintTag:
+		v, n1, err = varint.Uint.Unmarshal(bs[n:])
+	case anyUint8Tag:
+		v, n1, err = varint.Uint8.Unmarshal(bs[n:])
+	case anyUint16Tag:
+		v, n1, err = varint.Uint16.Unmarshal(bs[n:])
+	case anyUint32Tag:
+		v, n1, err = varint.Uint32.Unmarshal(bs[n:])
+	case anyUint64Tag:
+		v, n1, err = varint.Uint64.Unmarshal(bs[n:])
+	case anyFloat32Tag:
+		v, n1, err = varint.Float32.Unmarshal(bs[n:])
+	case anyFloat64Tag:
+		v, n1, err = varint.Float64.Unmarshal(bs[n:])
+	case anyStringTag:
+		v, n1, err = ord.String.Unmarshal(bs[n:])
+	case anyByteSliceTag:
+		v, n1, err = ord.ByteSlice.Unmarshal(bs[n:])
+	case anyTimeTag:
+		v, n1, err = varint.Time.Unmarshal(bs[n:])
+	case anyTypedTag:
+		v, n1, err = s.unmarshalTyped(bs[n:])
+	default:
+		err = com.ErrWrongFormat
+	}
+	n += n1
+	if err != nil {
+		v = nil
+	}
+	return
+}
+
+// Size returns the size of an encoded any value.
+//
+// It will panic with ErrUnsupportedType if the type of the value is not
+// supported.
+func (s anySer) Size(v any) (size int) {
+	switch t := v.(type) {
+	case nil:
+		return varint.PositiveInt.Size(anyNilTag)
+	case bool:
+		return varint.PositiveInt.Size(anyBoolTag) + ord.Bool.Size(t)
+	case int:
+		return varint.PositiveInt.Size(anyIntTag) + varint.Int.Size(t)
+	case int8:
+		return varint.PositiveInt.Size(anyInt8Tag) + varint.Int8.Size(t)
+	case int16:
+		return varint.PositiveInt.Size(anyInt16Tag) + varint.Int16.Size(t)
+	case int32:
+		return varint.PositiveInt.Size(anyInt32Tag) + varint.Int32.Size(t)
+	case int64:
+		return varint.PositiveInt.Size(anyInt64Tag) + varint.Int64.Size(t)
+	case uint:
+		return varint.PositiveInt.Size(anyUintTag) + varint.Uint.Size(t)
+	case uint8:
+		return varint.PositiveInt.Size(anyUint8Tag) + varint.Uint8.Size(t)
+	case uint16:
+		return varint.PositiveInt.Size(anyUint16Tag) + varint.Uint16.Size(t)
+	case uint32:
+		return varint.PositiveInt.Size(anyUint32Tag) + varint.Uint32.Size(t)
+	case uint64:
+		return varint.PositiveInt.Size(anyUint64Tag) + varint.Uint64.Size(t)
+	case float32:
+		return varint.PositiveInt.Size(anyFloat32Tag) + varint.Float32.Size(t)
+	case float64:
+		return varint.PositiveInt.Size(anyFloat64Tag) + varint.Float64.Size(t)
+	case string:
+		return varint.PositiveInt.Size(anyStringTag) + ord.String.Size(t)
+	case []byte:
+		return varint.PositiveInt.Size(anyByteSliceTag) + ord.ByteSlice.Size(t)
+	case time.Time:
+		return varint.PositiveInt.Size(anyTimeTag) + varint.Time.Size(t)
+	}
+	return varint.PositiveInt.Size(anyTypedTag) + s.typeSer(v).sizeAny(v)
+}
+
+// Skip skips an encoded any value.
+//
+// In addition to the number of skipped bytes, it may also return
+// com.ErrWrongFormat, if the tag is unknown, com.UnexpectedDTMError, if the
+// DTM is not registered, or a tag/DTM/value skipping error.
+func (s anySer) Skip(bs []byte) (n int, err error) {
+	tag, n, err := varint.PositiveInt.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	var n1 int
+	switch tag {
+	case anyNilTag:
+		return
+	case anyBoolTag:
+		n1, err = ord.Bool.Skip(bs[n:])
+	case anyIntTag:
+		n1, err = varint.Int.Skip(bs[n:])
+	case anyInt8Tag:
+		n1, err = varint.Int8.Skip(bs[n:])
+	case anyInt16Tag:
+		n1, err = varint.Int16.Skip(bs[n:])
+	case anyInt32Tag:
+		n1, err = varint.Int32.Skip(bs[n:])
+	case anyInt64Tag:
+		n1, err = varint.Int64.Skip(bs[n:])
+	case anyUintTag:
+		n1, err = varint.Uint.Skip(bs[n:])
+	case anyUint8Tag:
+		n1, err = varint.Uint8.Skip(bs[n:])
+	case anyUint16Tag:
+		n1, err = varint.Uint16.Skip(bs[n:])
+	case anyUint32Tag:
+		n1, err = varint.Uint32.Skip(bs[n:])
+	case anyUint64Tag:
+		n1, err = varint.Uint64.Skip(bs[n:])
+	case anyFloat32Tag:
+		n1, err = varint.Float32.Skip(bs[n:])
+	case anyFloat64Tag:
+		n1, err = varint.Float64.Skip(bs[n:])
+	case anyStringTag:
+		n1, err = ord.String.Skip(bs[n:])
+	case anyByteSliceTag:
+		n1, err = ord.ByteSlice.Skip(bs[n:])
+	case anyTimeTag:
+		n1, err = varint.Time.Skip(bs[n:])
+	case anyTypedTag:
+		n1, err = s.skipTyped(bs[n:])
+	default:
+		err = com.ErrWrongFormat
+	}
+	n += n1
+	return
+}
+
+func (s anySer) typeSer(v any) AnyTypeSer {
+	ser, pst := s.types[reflect.TypeOf(v)]
+	if !pst {
+		panic(ErrUnsupportedType)
+	}
+	return ser
+}
+
+func (s anySer) unmarshalTyped(bs []byte) (v any, n int, err error) {
+	ser, n, err := s.dtmSer(bs)
+	if err != nil {
+		return
+	}
+	v, n1, err := ser.unmarshalDataAny(bs[n:])
+	n += n1
+	return
+}
+
+func (s anySer) skipTyped(bs []byte) (n int, err error) {
+	ser, n, err := s.dtmSer(bs)
+	if err != nil {
+		return
+	}
+	n1, err := ser.SkipData(bs[n:])
+	n += n1
+	return
+}
+
+func (s anySer) dtmSer(bs []byte) (ser AnyTypeSer, n int, err error) {
+	dtm, n, err := DTMSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	ser, pst := s.dtms[dtm]
+	if !pst {
+		err = com.NewUnexpectedDTMError(dtm)
+	}
+	return
+}
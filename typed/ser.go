@@ -4,6 +4,8 @@
 package typed
 
 import (
+	"reflect"
+
 	com "github.com/mus-format/common-go"
 	"github.com/mus-format/mus-go"
 )
@@ -85,3 +87,19 @@ func (d Ser[T]) UnmarshalData(bs []byte) (t T, n int, err error) {
 func (d Ser[T]) SkipData(bs []byte) (n int, err error) {
 	return d.ser.Skip(bs)
 }
+
+func (d Ser[T]) anyType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (d Ser[T]) marshalAny(v any, bs []byte) (n int) {
+	return d.Marshal(v.(T), bs)
+}
+
+func (d Ser[T]) unmarshalDataAny(bs []byte) (v any, n int, err error) {
+	return d.UnmarshalData(bs)
+}
+
+func (d Ser[T]) sizeAny(v any) (size int) {
+	return d.Size(v.(T))
+}
@@ -0,0 +1,31 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/mus-format/mus-go/test"
+)
+
+func FuzzTyped_AnySer(f *testing.F) {
+	ser := NewAnySer(NewSer[Foo](FooDTM, fooMUS{}))
+	f.Add(int64(0), "", 0.0, false)
+	f.Add(int64(-100), "hello world", 1.5, true)
+	f.Fuzz(func(t *testing.T, i int64, str string, fl float64, b bool) {
+		vs := []any{i, str, b, Foo{Num: int(i), Str: str}}
+		if fl == fl {
+			vs = append(vs, fl)
+		}
+		test.Test(vs, ser, t)
+		test.TestSkip(vs, ser, t)
+	})
+}
+
+func FuzzTyped_AnySerUnmarshal(f *testing.F) {
+	ser := NewAnySer(NewSer[Foo](FooDTM, fooMUS{}))
+	f.Add([]byte{17, 0, 2, 0})
+	f.Add([]byte{16, 2, 2})
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
@@ -1,11 +1,16 @@
 package typed
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	com "github.com/mus-format/common-go"
 	"github.com/mus-format/mus-go"
+	"github.com/mus-format/mus-go/ord"
+	"github.com/mus-format/mus-go/test"
 	"github.com/mus-format/mus-go/test/mock"
+	"github.com/mus-format/mus-go/varint"
 	asserterror "github.com/ymz-ncnk/assert/error"
 )
 
@@ -196,3 +201,159 @@ func TestDTMSer(t *testing.T) {
 			asserterror.EqualError(t, mus.ErrTooSmallByteSlice, err)
 		})
 }
+
+func TestAnySer(t *testing.T) {
+	var (
+		fooSer = NewSer[Foo](FooDTM, fooMUS{})
+		ser    = NewAnySer(fooSer)
+	)
+
+	t.Run("Any serializer should succeed with built-in types",
+		func(t *testing.T) {
+			vs := []any{
+				nil, true, false,
+				int(-1), int8(-2), int16(-3), int32(-4), int64(math.MinInt64),
+				uint(1), uint8(2), uint16(3), uint32(4), uint64(math.MaxUint64),
+				float32(1.5), float64(-2.5), "", "hello world",
+				[]byte{1, 2, 3},
+				time.Unix(1700000000, 123456789),
+			}
+			test.Test(vs, ser, t)
+			test.TestSkip(vs, ser, t)
+		})
+
+	t.Run("Any serializer should succeed with registered types",
+		func(t *testing.T) {
+			vs := []any{Foo{Num: 11, Str: "hello world"}, Foo{}}
+			test.Test(vs, ser, t)
+			test.TestSkip(vs, ser, t)
+		})
+
+	t.Run("Any serializer should work as a map value serializer",
+		func(t *testing.T) {
+			var (
+				mser = ord.NewMapSer[string, any](ord.String, ser)
+				vs   = []map[string]any{{
+					"host":  "host-1",
+					"port":  8080,
+					"ratio": 0.5,
+					"debug": true,
+					"foo":   Foo{Num: 1},
+					"none":  nil,
+				}}
+			)
+			test.Test(vs, mser, t)
+			test.TestSkip(vs, mser, t)
+		})
+
+	t.Run("Built-in tags should not change", func(t *testing.T) {
+		for _, c := range []struct {
+			v      any
+			wantBS []byte
+		}{
+			{nil, []byte{0}},
+			{true, []byte{1, 1}},
+			{int(-1), []byte{2, 1}},
+			{uint64(1), []byte{11, 1}},
+			{"a", []byte{14, 1, 'a'}},
+			{[]byte{1}, []byte{15, 1, 1}},
+			{time.Unix(1, 2), []byte{16, 2, 2}},
+			{Foo{Num: 1}, []byte{17, 0, 2, 0}},
+		} {
+			bs := make([]byte, ser.Size(c.v))
+			ser.Marshal(c.v, bs)
+			asserterror.EqualDeep(t, bs, c.wantBS)
+		}
+	})
+
+	t.Run("Marshal and Size should panic with ErrUnsupportedType if the type is not supported",
+		func(t *testing.T) {
+			type MyInt int
+			defer func() {
+				asserterror.Equal[any](t, recover(), ErrUnsupportedType)
+			}()
+			ser.Size(MyInt(1))
+		})
+
+	t.Run("NewAnySer should panic with ErrDuplicateDTM if DTMs repeat",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), ErrDuplicateDTM)
+			}()
+			NewAnySer(fooSer, NewSer[int](FooDTM, varint.Int))
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrWrongFormat if the tag is unknown",
+		func(t *testing.T) {
+			test.TestUnmarshalOnly([]byte{18}, ser,
+				test.UnmarshalResult[any]{N: 1, Err: com.ErrWrongFormat}, nil, t)
+			test.TestSkipOnly([]byte{18}, ser,
+				test.SkipResult{N: 1, Err: com.ErrWrongFormat}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.UnexpectedDTMError if the DTM is not registered",
+		func(t *testing.T) {
+			wantErr := com.NewUnexpectedDTMError(5)
+			test.TestUnmarshalOnly([]byte{17, 5}, ser,
+				test.UnmarshalResult[any]{N: 2, Err: wantErr}, nil, t)
+			test.TestSkipOnly([]byte{17, 5}, ser,
+				test.SkipResult{N: 2, Err: wantErr}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrWrongFormat if time nanoseconds are out of range",
+		func(t *testing.T) {
+			bs := []byte{16, 0, 0x80, 0x94, 0xeb, 0xdc, 0x03}
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[any]{N: 7, Err: com.ErrWrongFormat}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 7, Err: com.ErrWrongFormat}, nil, t)
+		})
+
+	t.Run("Unmarshal and Skip should return com.ErrOverflow if the value does not fit its type",
+		func(t *testing.T) {
+			bs := []byte{4, 0xff, 0xff, 0x7f}
+			test.TestUnmarshalOnly(bs, ser,
+				test.UnmarshalResult[any]{N: 4, Err: com.ErrOverflow}, nil, t)
+			test.TestSkipOnly(bs, ser,
+				test.SkipResult{N: 4, Err: com.ErrOverflow}, nil, t)
+		})
+
+	t.Run("If value unmarshalling fails with an error, Unmarshal should return it",
+		func(t *testing.T) {
+			test.TestUnmarshalOnly([]byte{14, 3, 'a'}, ser,
+				test.UnmarshalResult[any]{N: 2, Err: mus.ErrTooSmallByteSlice},
+				nil, t)
+		})
+}
+
+type fooMUS struct{}
+
+func (s fooMUS) Marshal(v Foo, bs []byte) (n int) {
+	n = varint.Int.Marshal(v.Num, bs)
+	return n + ord.String.Marshal(v.Str, bs[n:])
+}
+
+func (s fooMUS) Unmarshal(bs []byte) (v Foo, n int, err error) {
+	v.Num, n, err = varint.Int.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	var n1 int
+	v.Str, n1, err = ord.String.Unmarshal(bs[n:])
+	n += n1
+	return
+}
+
+func (s fooMUS) Size(v Foo) (size int) {
+	return varint.Int.Size(v.Num) + ord.String.Size(v.Str)
+}
+
+func (s fooMUS) Skip(bs []byte) (n int, err error) {
+	n, err = varint.Int.Skip(bs)
+	if err != nil {
+		return
+	}
+	n1, err := ord.String.Skip(bs[n:])
+	n += n1
+	return
+}
@@ -0,0 +1,244 @@
+package unsafe
+
+import (
+	"math"
+	"math/bits"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go/raw"
+)
+
+// BE contains big-endian (network byte order) counterparts of the numeric
+// serializers, compatible with raw.BE. On big-endian hosts values are copied
+// as is, on little-endian ones their bytes are reversed first.
+var BE = BigEndian{
+	Uint64:  beInteger64Ser[uint64]{},
+	Uint32:  beInteger32Ser[uint32]{},
+	Uint16:  beInteger16Ser[uint16]{},
+	Uint8:   Uint8,
+	Int64:   beInteger64Ser[int64]{},
+	Int32:   beInteger32Ser[int32]{},
+	Int16:   beInteger16Ser[int16]{},
+	Int8:    Int8,
+	Float64: beFloat64Ser{},
+	Float32: beFloat32Ser{},
+}
+
+// BigEndian is a set of big-endian numeric serializers. Single-byte values
+// have no byte order, so Uint8 and Int8 are the same as the package ones.
+type BigEndian struct {
+	Uint64  beInteger64Ser[uint64]
+	Uint32  beInteger32Ser[uint32]
+	Uint16  beInteger16Ser[uint16]
+	Uint8   uint8Ser
+	Int64   beInteger64Ser[int64]
+	Int32   beInteger32Ser[int32]
+	Int16   beInteger16Ser[int16]
+	Int8    int8Ser
+	Float64 beFloat64Ser
+	Float32 beFloat32Ser
+}
+
+// integer64 -------------------------------------------------------------------
+
+type beInteger64Ser[T ~uint64 | ~int64] struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) 64-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beInteger64Ser[T]) Marshal(v T, bs []byte) (n int) {
+	return marshalInteger64(toBE64(uint64(v)), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) 64-bit integer value from bs.
+//
+// In addition to the integer value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beInteger64Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	uv, n, err := unmarshalInteger64[uint64](bs)
+	if err != nil {
+		return
+	}
+	return T(toBE64(uv)), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) 64-bit integer value.
+func (s beInteger64Ser[T]) Size(v T) (size int) {
+	return com.Num64RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) 64-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beInteger64Ser[T]) Skip(bs []byte) (n int, err error) {
+	return raw.SkipInteger64(bs)
+}
+
+// integer32 -------------------------------------------------------------------
+
+type beInteger32Ser[T ~uint32 | ~int32] struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) 32-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beInteger32Ser[T]) Marshal(v T, bs []byte) (n int) {
+	return marshalInteger32(toBE32(uint32(v)), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) 32-bit integer value from bs.
+//
+// In addition to the integer value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beInteger32Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	uv, n, err := unmarshalInteger32[uint32](bs)
+	if err != nil {
+		return
+	}
+	return T(toBE32(uv)), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) 32-bit integer value.
+func (s beInteger32Ser[T]) Size(v T) (size int) {
+	return com.Num32RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) 32-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beInteger32Ser[T]) Skip(bs []byte) (n int, err error) {
+	return raw.SkipInteger32(bs)
+}
+
+// integer16 -------------------------------------------------------------------
+
+type beInteger16Ser[T com.Integer16] struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) 16-bit integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beInteger16Ser[T]) Marshal(v T, bs []byte) (n int) {
+	return marshalInteger16(toBE16(uint16(v)), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) 16-bit integer value from bs.
+//
+// In addition to the integer value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beInteger16Ser[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	uv, n, err := unmarshalInteger16[uint16](bs)
+	if err != nil {
+		return
+	}
+	return T(toBE16(uv)), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) 16-bit integer value.
+func (s beInteger16Ser[T]) Size(v T) (size int) {
+	return com.Num16RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) 16-bit integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beInteger16Ser[T]) Skip(bs []byte) (n int, err error) {
+	return raw.SkipInteger16(bs)
+}
+
+// float64 ---------------------------------------------------------------------
+
+type beFloat64Ser struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) float64 value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beFloat64Ser) Marshal(v float64, bs []byte) (n int) {
+	return marshalInteger64(toBE64(math.Float64bits(v)), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) float64 value from bs.
+//
+// In addition to the float64 value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beFloat64Ser) Unmarshal(bs []byte) (v float64, n int, err error) {
+	uv, n, err := unmarshalInteger64[uint64](bs)
+	if err != nil {
+		return
+	}
+	return math.Float64frombits(toBE64(uv)), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) float64 value.
+func (s beFloat64Ser) Size(v float64) (size int) {
+	return com.Num64RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) float64 value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beFloat64Ser) Skip(bs []byte) (n int, err error) {
+	return raw.SkipInteger64(bs)
+}
+
+// float32 ---------------------------------------------------------------------
+
+type beFloat32Ser struct{}
+
+// Marshal fills bs with an encoded (Raw, big-endian) float32 value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s beFloat32Ser) Marshal(v float32, bs []byte) (n int) {
+	return marshalInteger32(toBE32(math.Float32bits(v)), bs)
+}
+
+// Unmarshal parses an encoded (Raw, big-endian) float32 value from bs.
+//
+// In addition to the float32 value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice.
+func (s beFloat32Ser) Unmarshal(bs []byte) (v float32, n int, err error) {
+	uv, n, err := unmarshalInteger32[uint32](bs)
+	if err != nil {
+		return
+	}
+	return math.Float32frombits(toBE32(uv)), n, nil
+}
+
+// Size returns the size of an encoded (Raw, big-endian) float32 value.
+func (s beFloat32Ser) Size(v float32) (size int) {
+	return com.Num32RawSize
+}
+
+// Skip skips an encoded (Raw, big-endian) float32 value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s beFloat32Ser) Skip(bs []byte) (n int, err error) {
+	return raw.SkipInteger32(bs)
+}
+
+// -----------------------------------------------------------------------------
+
+// toBE64 converts v between the host and big-endian byte orders.
+func toBE64(v uint64) uint64 {
+	if littleEndian {
+		return bits.ReverseBytes64(v)
+	}
+	return v
+}
+
+func toBE32(v uint32) uint32 {
+	if littleEndian {
+		return bits.ReverseBytes32(v)
+	}
+	return v
+}
+
+func toBE16(v uint16) uint16 {
+	if littleEndian {
+		return bits.ReverseBytes16(v)
+	}
+	return v
+}
@@ -0,0 +1,61 @@
+package unsafe
+
+import (
+	"errors"
+	"reflect"
+	unsafe_mod "unsafe"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go/ord"
+)
+
+// ErrNotBoolArray means that the type parameter of NewBoolArraySer is not a
+// [N]bool array type.
+var ErrNotBoolArray = errors.New(com.ErrorPrefix + "not a bool array type")
+
+// NewBoolArraySer returns a new fixed-width [N]bool array serializer. An array
+// is encoded without a length, as N flags packed 8 per byte.
+//
+// Panics with ErrNotBoolArray if T is not a [N]bool array type.
+func NewBoolArraySer[T any]() (s boolArraySer[T]) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Array || t.Elem().Kind() != reflect.Bool {
+		panic(ErrNotBoolArray)
+	}
+	return boolArraySer[T]{length: t.Len()}
+}
+
+type boolArraySer[T any] struct {
+	length int
+}
+
+// Marshal fills bs with an encoded bool array value.
+//
+// Returns the number of used bytes. It will panic if bs is too small.
+func (s boolArraySer[T]) Marshal(v T, bs []byte) (n int) {
+	sl := unsafe_mod.Slice((*bool)(unsafe_mod.Pointer(&v)), s.length)
+	return ord.MarshalPackedBools(sl, bs)
+}
+
+// Unmarshal parses an encoded bool array value from bs.
+//
+// In addition to the bool array value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice or com.ErrWrongFormat.
+func (s boolArraySer[T]) Unmarshal(bs []byte) (v T, n int, err error) {
+	sl := unsafe_mod.Slice((*bool)(unsafe_mod.Pointer(&v)), s.length)
+	n, err = ord.UnmarshalPackedBools(bs, sl)
+	return
+}
+
+// Size returns the size of an encoded bool array value.
+func (s boolArraySer[T]) Size(v T) (size int) {
+	return ord.SizePackedBools(s.length)
+}
+
+// Skip skips an encoded bool array value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrWrongFormat.
+func (s boolArraySer[T]) Skip(bs []byte) (n int, err error) {
+	return ord.SkipPackedBools(s.length, bs)
+}
@@ -0,0 +1,106 @@
+package unsafe
+
+import com "github.com/mus-format/common-go"
+
+var (
+	// Complex128 is a complex128 serializer.
+	Complex128 = complex128Ser{}
+	// Complex64 is a complex64 serializer.
+	Complex64 = complex64Ser{}
+)
+
+// complex128 ------------------------------------------------------------------
+
+type complex128Ser struct{}
+
+// Marshal fills bs with an encoded (Raw) complex128 value, as the real part
+// followed by the imaginary part.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s complex128Ser) Marshal(v complex128, bs []byte) (n int) {
+	n = Float64.Marshal(real(v), bs)
+	return n + Float64.Marshal(imag(v), bs[n:])
+}
+
+// Unmarshal parses an encoded (Raw) complex128 value from bs.
+//
+// In addition to the complex128 value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice.
+func (s complex128Ser) Unmarshal(bs []byte) (v complex128, n int, err error) {
+	re, n, err := Float64.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	im, n1, err := Float64.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	return complex(re, im), n, nil
+}
+
+// Size returns the size of an encoded (Raw) complex128 value.
+func (s complex128Ser) Size(v complex128) (size int) {
+	return 2 * com.Num64RawSize
+}
+
+// Skip skips an encoded (Raw) complex128 value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s complex128Ser) Skip(bs []byte) (n int, err error) {
+	if n, err = Float64.Skip(bs); err != nil {
+		return
+	}
+	n1, err := Float64.Skip(bs[n:])
+	n += n1
+	return
+}
+
+// complex64 -------------------------------------------------------------------
+
+type complex64Ser struct{}
+
+// Marshal fills bs with an encoded (Raw) complex64 value, as the real part
+// followed by the imaginary part.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s complex64Ser) Marshal(v complex64, bs []byte) (n int) {
+	n = Float32.Marshal(real(v), bs)
+	return n + Float32.Marshal(imag(v), bs[n:])
+}
+
+// Unmarshal parses an encoded (Raw) complex64 value from bs.
+//
+// In addition to the complex64 value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice.
+func (s complex64Ser) Unmarshal(bs []byte) (v complex64, n int, err error) {
+	re, n, err := Float32.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	im, n1, err := Float32.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	return complex(re, im), n, nil
+}
+
+// Size returns the size of an encoded (Raw) complex64 value.
+func (s complex64Ser) Size(v complex64) (size int) {
+	return 2 * com.Num32RawSize
+}
+
+// Skip skips an encoded (Raw) complex64 value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice.
+func (s complex64Ser) Skip(bs []byte) (n int, err error) {
+	if n, err = Float32.Skip(bs); err != nil {
+		return
+	}
+	n1, err := Float32.Skip(bs[n:])
+	n += n1
+	return
+}
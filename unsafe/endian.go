@@ -0,0 +1,9 @@
+package unsafe
+
+import unsafe_mod "unsafe"
+
+// littleEndian reports whether the host byte order is little-endian.
+var littleEndian = func() bool {
+	v := uint16(1)
+	return *(*byte)(unsafe_mod.Pointer(&v)) == 1
+}()
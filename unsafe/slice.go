@@ -0,0 +1,153 @@
+package unsafe
+
+import (
+	unsafe_mod "unsafe"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	slopts "github.com/mus-format/mus-go/options/slice"
+	"github.com/mus-format/mus-go/raw"
+	"github.com/mus-format/mus-go/varint"
+)
+
+// NewSliceSer returns a new packed numeric slice serializer. It produces the
+// same encoding as raw.NewSliceSer, but on little-endian hosts copies the whole
+// slice body with a single memcpy. To specify a length or element validator,
+// use NewValidSliceSer instead.
+func NewSliceSer[T raw.Numeric](opts ...slopts.SetOption[T]) sliceSer[T] {
+	o := slopts.Options[T]{}
+	slopts.Apply(opts, &o)
+
+	return newSliceSer(o, raw.NewSliceSer(opts...))
+}
+
+// NewValidSliceSer returns a new valid packed numeric slice serializer.
+func NewValidSliceSer[T raw.Numeric](opts ...slopts.SetOption[T]) validSliceSer[T] {
+	o := slopts.Options[T]{}
+	slopts.Apply(opts, &o)
+
+	return validSliceSer[T]{
+		sliceSer: newSliceSer(o, raw.NewValidSliceSer(opts...)),
+		lenVl:    o.LenVl,
+		elemVl:   o.ElemVl,
+	}
+}
+
+func newSliceSer[T raw.Numeric](o slopts.Options[T],
+	rawSer mus.Serializer[[]T],
+) sliceSer[T] {
+	var lenSer mus.Serializer[int] = varint.PositiveInt
+	if o.LenSer != nil {
+		lenSer = o.LenSer
+	}
+	var t T
+	return sliceSer[T]{
+		lenSer:   lenSer,
+		elemSize: int(unsafe_mod.Sizeof(t)),
+		rawSer:   rawSer,
+	}
+}
+
+type sliceSer[T raw.Numeric] struct {
+	lenSer   mus.Serializer[int]
+	elemSize int
+	rawSer   mus.Serializer[[]T]
+}
+
+// Marshal fills bs with an encoded (Raw) slice value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s sliceSer[T]) Marshal(v []T, bs []byte) (n int) {
+	if !littleEndian {
+		return s.rawSer.Marshal(v, bs)
+	}
+	n = s.lenSer.Marshal(len(v), bs)
+	l := n + len(v)*s.elemSize
+	if len(bs) < l {
+		panic(mus.ErrTooSmallByteSlice)
+	}
+	return n + copy(bs[n:l], s.bytes(v))
+}
+
+// Unmarshal parses an encoded (Raw) slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a length
+// unmarshalling error.
+func (s sliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	if !littleEndian {
+		return s.rawSer.Unmarshal(bs)
+	}
+	return s.unmarshal(bs, nil, nil)
+}
+
+// Size returns the size of an encoded (Raw) slice value.
+func (s sliceSer[T]) Size(v []T) (size int) {
+	return s.rawSer.Size(v)
+}
+
+// Skip skips an encoded (Raw) slice value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, or a length unmarshalling
+// error.
+func (s sliceSer[T]) Skip(bs []byte) (n int, err error) {
+	return s.rawSer.Skip(bs)
+}
+
+func (s sliceSer[T]) unmarshal(bs []byte, lenVl com.Validator[int],
+	elemVl com.Validator[T],
+) (v []T, n int, err error) {
+	length, n, err := s.lenSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > (len(bs)-n)/s.elemSize {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	if lenVl != nil {
+		if err = lenVl.Validate(length); err != nil {
+			return
+		}
+	}
+	v = make([]T, length)
+	n += copy(s.bytes(v), bs[n:])
+	if elemVl != nil {
+		for i := range v {
+			if err = elemVl.Validate(v[i]); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (s sliceSer[T]) bytes(v []T) []byte {
+	return unsafe_mod.Slice((*byte)(unsafe_mod.Pointer(unsafe_mod.SliceData(v))),
+		len(v)*s.elemSize)
+}
+
+// valid -----------------------------------------------------------------------
+
+type validSliceSer[T raw.Numeric] struct {
+	sliceSer[T]
+	lenVl  com.Validator[int]
+	elemVl com.Validator[T]
+}
+
+// Unmarshal parses an encoded (Raw) slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, a length
+// unmarshalling error, or a length/element validation error.
+func (s validSliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	if !littleEndian {
+		return s.rawSer.Unmarshal(bs)
+	}
+	return s.unmarshal(bs, s.lenVl, s.elemVl)
+}
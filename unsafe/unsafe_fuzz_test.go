@@ -1,6 +1,7 @@
 package unsafe
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	com "github.com/mus-format/common-go"
 	bslopts "github.com/mus-format/mus-go/options/byte_slice"
 	stropts "github.com/mus-format/mus-go/options/string"
+	"github.com/mus-format/mus-go/raw"
 	"github.com/mus-format/mus-go/test"
 	"github.com/mus-format/mus-go/varint"
 )
@@ -292,6 +294,84 @@ func FuzzUnsafe_Float32Unmarshal(f *testing.F) {
 	})
 }
 
+// be --------------------------------------------------------------------------
+
+func FuzzUnsafe_BE(f *testing.F) {
+	f.Fuzz(func(t *testing.T, v uint64, fv float64) {
+		test.Test([]uint64{v}, BE.Uint64, t)
+		test.TestSkip([]uint64{v}, BE.Uint64, t)
+		test.Test([]int64{int64(v)}, BE.Int64, t)
+		test.Test([]uint32{uint32(v)}, BE.Uint32, t)
+		test.Test([]int32{int32(v)}, BE.Int32, t)
+		test.Test([]uint16{uint16(v)}, BE.Uint16, t)
+		test.Test([]int16{int16(v)}, BE.Int16, t)
+		test.Test([]float64{fv}, BE.Float64, t)
+		test.Test([]float32{float32(fv)}, BE.Float32, t)
+		var (
+			bs    = make([]byte, 8)
+			rawBs = make([]byte, 8)
+		)
+		BE.Uint64.Marshal(v, bs)
+		raw.BE.Uint64.Marshal(v, rawBs)
+		if !bytes.Equal(bs, rawBs) {
+			t.Errorf("unexpected bs, want %v actual %v", rawBs, bs)
+		}
+	})
+}
+
+func FuzzUnsafe_BEUnmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		BE.Uint64.Unmarshal(bs)
+		BE.Uint64.Skip(bs)
+		BE.Int32.Unmarshal(bs)
+		BE.Int32.Skip(bs)
+		BE.Uint16.Unmarshal(bs)
+		BE.Uint16.Skip(bs)
+		BE.Float64.Unmarshal(bs)
+		BE.Float32.Unmarshal(bs)
+	})
+}
+
+// complex128 ------------------------------------------------------------------
+
+func FuzzUnsafe_Complex128(f *testing.F) {
+	seeds := []float64{0, 1, -1, 3.14}
+	for _, seed := range seeds {
+		f.Add(seed, -seed)
+	}
+	f.Fuzz(func(t *testing.T, re, im float64) {
+		test.Test([]complex128{complex(re, im)}, Complex128, t)
+		test.TestSkip([]complex128{complex(re, im)}, Complex128, t)
+	})
+}
+
+func FuzzUnsafe_Complex128Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Complex128.Unmarshal(bs)
+		Complex128.Skip(bs)
+	})
+}
+
+// complex64 -------------------------------------------------------------------
+
+func FuzzUnsafe_Complex64(f *testing.F) {
+	seeds := []float32{0, 1, -1, 3.14}
+	for _, seed := range seeds {
+		f.Add(seed, -seed)
+	}
+	f.Fuzz(func(t *testing.T, re, im float32) {
+		test.Test([]complex64{complex(re, im)}, Complex64, t)
+		test.TestSkip([]complex64{complex(re, im)}, Complex64, t)
+	})
+}
+
+func FuzzUnsafe_Complex64Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Complex64.Unmarshal(bs)
+		Complex64.Skip(bs)
+	})
+}
+
 // string ----------------------------------------------------------------------
 
 func FuzzUnsafe_String(f *testing.F) {
@@ -427,3 +507,28 @@ func FuzzUnsafe_ArrayUnmarshal(f *testing.F) {
 		ser.Skip(bs)
 	})
 }
+
+// slice -----------------------------------------------------------------------
+
+func FuzzUnsafe_Slice(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		if len(bs) > maxLen {
+			bs = bs[:maxLen]
+		}
+		v := make([]float64, len(bs))
+		for i, b := range bs {
+			v[i] = float64(b) / 3
+		}
+		ser := NewSliceSer[float64]()
+		test.Test([][]float64{v}, ser, t)
+		test.TestSkip([][]float64{v}, ser, t)
+	})
+}
+
+func FuzzUnsafe_SliceUnmarshal(f *testing.F) {
+	ser := NewSliceSer[int32]()
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
@@ -2,6 +2,7 @@ package unsafe
 
 import (
 	"errors"
+	"math"
 	"os"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/mus-format/mus-go"
 	arropts "github.com/mus-format/mus-go/options/array"
 	bslopts "github.com/mus-format/mus-go/options/byte_slice"
+	slopts "github.com/mus-format/mus-go/options/slice"
 	stropts "github.com/mus-format/mus-go/options/string"
 	"github.com/mus-format/mus-go/raw"
 	"github.com/mus-format/mus-go/test"
@@ -415,6 +417,133 @@ func TestUnsafe_Float32(t *testing.T) {
 		})
 }
 
+func TestUnsafe_BE(t *testing.T) {
+	t.Run("BE serializers should succeed", func(t *testing.T) {
+		test.Test(ctest.Uint64TestCases, BE.Uint64, t)
+		test.TestSkip(ctest.Uint64TestCases, BE.Uint64, t)
+		test.Test(ctest.Uint32TestCases, BE.Uint32, t)
+		test.TestSkip(ctest.Uint32TestCases, BE.Uint32, t)
+		test.Test(ctest.Uint16TestCases, BE.Uint16, t)
+		test.TestSkip(ctest.Uint16TestCases, BE.Uint16, t)
+		test.Test(ctest.Uint8TestCases, BE.Uint8, t)
+		test.Test(ctest.Int64TestCases, BE.Int64, t)
+		test.TestSkip(ctest.Int64TestCases, BE.Int64, t)
+		test.Test(ctest.Int32TestCases, BE.Int32, t)
+		test.TestSkip(ctest.Int32TestCases, BE.Int32, t)
+		test.Test(ctest.Int16TestCases, BE.Int16, t)
+		test.TestSkip(ctest.Int16TestCases, BE.Int16, t)
+		test.Test(ctest.Int8TestCases, BE.Int8, t)
+		test.Test(ctest.Float64TestCases, BE.Float64, t)
+		test.TestSkip(ctest.Float64TestCases, BE.Float64, t)
+		test.Test(ctest.Float32TestCases, BE.Float32, t)
+		test.TestSkip(ctest.Float32TestCases, BE.Float32, t)
+	})
+
+	t.Run("BE serializers should be compatible with raw.BE", func(t *testing.T) {
+		var (
+			bs    = make([]byte, 8)
+			rawBs = make([]byte, 8)
+		)
+		for _, v := range ctest.Uint64TestCases {
+			BE.Uint64.Marshal(v, bs)
+			raw.BE.Uint64.Marshal(v, rawBs)
+			asserterror.EqualDeep(t, bs, rawBs)
+		}
+		for _, v := range ctest.Int32TestCases {
+			BE.Int32.Marshal(v, bs)
+			raw.BE.Int32.Marshal(v, rawBs)
+			asserterror.EqualDeep(t, bs[:4], rawBs[:4])
+		}
+		for _, v := range ctest.Int16TestCases {
+			BE.Int16.Marshal(v, bs)
+			raw.BE.Int16.Marshal(v, rawBs)
+			asserterror.EqualDeep(t, bs[:2], rawBs[:2])
+		}
+		for _, v := range ctest.Float64TestCases {
+			BE.Float64.Marshal(v, bs)
+			raw.BE.Float64.Marshal(v, rawBs)
+			asserterror.EqualDeep(t, bs, rawBs)
+		}
+		for _, v := range ctest.Float32TestCases {
+			BE.Float32.Marshal(v, bs)
+			raw.BE.Float32.Marshal(v, rawBs)
+			asserterror.EqualDeep(t, bs[:4], rawBs[:4])
+		}
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			bs := []byte{1}
+			_, n, err := BE.Uint64.Unmarshal(bs)
+			asserterror.Equal(t, n, 0)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Int32.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Int16.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Float64.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+			_, _, err = BE.Float32.Unmarshal(bs)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestUnsafe_Complex128(t *testing.T) {
+	t.Run("Complex128 serializer should succeed", func(t *testing.T) {
+		cases := []complex128{0, 1 + 2i, -3.5 - 0.25i,
+			complex(math.MaxFloat64, math.SmallestNonzeroFloat64),
+			complex(math.Inf(1), math.NaN())}
+		test.Test(cases, Complex128, t)
+		test.TestSkip(cases, Complex128, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no imaginary part",
+		func(t *testing.T) {
+			var (
+				v    = complex(1.5, 2.5)
+				bs   = make([]byte, Complex128.Size(v))
+				want = test.UnmarshalResult[complex128]{
+					N:   8,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			Complex128.Marshal(v, bs)
+			bs = bs[:Float64.Size(real(v))+1]
+			test.TestUnmarshalOnly(bs, Complex128, want, nil, t)
+			n, err := Complex128.Skip(bs)
+			asserterror.Equal(t, n, want.N)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestUnsafe_Complex64(t *testing.T) {
+	t.Run("Complex64 serializer should succeed", func(t *testing.T) {
+		cases := []complex64{0, 1 + 2i, -3.5 - 0.25i,
+			complex(math.MaxFloat32, math.SmallestNonzeroFloat32),
+			complex(float32(math.Inf(-1)), float32(math.NaN()))}
+		test.Test(cases, Complex64, t)
+		test.TestSkip(cases, Complex64, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no imaginary part",
+		func(t *testing.T) {
+			var (
+				v    = complex64(complex(1.5, 2.5))
+				bs   = make([]byte, Complex64.Size(v))
+				want = test.UnmarshalResult[complex64]{
+					N:   4,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			Complex64.Marshal(v, bs)
+			bs = bs[:Float32.Size(real(v))+1]
+			test.TestUnmarshalOnly(bs, Complex64, want, nil, t)
+			n, err := Complex64.Skip(bs)
+			asserterror.Equal(t, n, want.N)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
 func TestUnsafe_Bool(t *testing.T) {
 	t.Run("Bool serializer should succeed", func(t *testing.T) {
 		ser := Bool
@@ -861,3 +990,166 @@ func NegativeLengthBs() (n int, bs []byte) {
 	varint.PositiveInt.Marshal(-1, bs)
 	return
 }
+
+func TestUnsafe_BoolArray(t *testing.T) {
+	t.Run("BoolArray serializer should succeed", func(t *testing.T) {
+		var (
+			arrs = [][10]bool{
+				{},
+				{true, false, true, true, false, false, true, false, true, true},
+			}
+			ser = NewBoolArraySer[[10]bool]()
+		)
+		test.Test(arrs, ser, t)
+		test.TestSkip(arrs, ser, t)
+	})
+
+	t.Run("Size should not include a length", func(t *testing.T) {
+		ser := NewBoolArraySer[[10]bool]()
+		asserterror.Equal(t, ser.Size([10]bool{}), 2)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1}
+				want = test.UnmarshalResult[[10]bool]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewBoolArraySer[[10]bool](), want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrWrongFormat if unused bits are not zero",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1, 0x04}
+				want = test.UnmarshalResult[[10]bool]{
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewBoolArraySer[[10]bool](), want, nil, t)
+		})
+
+	t.Run("NewBoolArraySer should panic with ErrNotBoolArray if T is not a bool array",
+		func(t *testing.T) {
+			testNotBoolArray[[8]int64](t)
+			testNotBoolArray[[]bool](t)
+			testNotBoolArray[bool](t)
+		})
+}
+
+func testNotBoolArray[T any](t *testing.T) {
+	defer func() {
+		asserterror.Equal[any](t, recover(), ErrNotBoolArray)
+	}()
+	NewBoolArraySer[T]()
+}
+
+func TestUnsafe_Slice(t *testing.T) {
+	t.Run("Slice serializer should succeed for all numeric types",
+		func(t *testing.T) {
+			testSlice(t, []uint64{0, 1, 1 << 63, math.MaxUint64})
+			testSlice(t, []uint32{0, 1, math.MaxUint32})
+			testSlice(t, []uint16{0, 1, math.MaxUint16})
+			testSlice(t, []uint8{0, 1, math.MaxUint8})
+			testSlice(t, []uint{0, 1, math.MaxUint})
+			testSlice(t, []int64{0, -1, math.MinInt64, math.MaxInt64})
+			testSlice(t, []int32{0, -1, math.MinInt32, math.MaxInt32})
+			testSlice(t, []int16{0, -1, math.MinInt16, math.MaxInt16})
+			testSlice(t, []int8{0, -1, math.MinInt8, math.MaxInt8})
+			testSlice(t, []int{0, -1, math.MinInt, math.MaxInt})
+			testSlice(t, []float64{0, -1, math.MaxFloat64, math.Inf(-1)})
+			testSlice(t, []float32{0, -1, math.MaxFloat32, float32(math.Inf(1))})
+		})
+
+	t.Run("Slice serializer should produce the same encoding as the raw slice serializer",
+		func(t *testing.T) {
+			var (
+				v      = []float64{0, -1, 3.14}
+				ser    = NewSliceSer[float64]()
+				rawSer = raw.NewSliceSer[float64]()
+				bs     = make([]byte, ser.Size(v))
+				wantBs = make([]byte, rawSer.Size(v))
+			)
+			ser.Marshal(v, bs)
+			rawSer.Marshal(v, wantBs)
+			asserterror.EqualDeep(t, bs, wantBs)
+		})
+
+	t.Run("Unmarshal should return ErrNegativeLength if meets a negative length",
+		func(t *testing.T) {
+			var (
+				wantN, bs = NegativeLengthBs()
+				want      = test.UnmarshalResult[[]int32]{
+					N:   wantN,
+					Err: com.ErrNegativeLength,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewSliceSer[int32](), want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{2, 1, 2, 3, 4, 5}
+				want = test.UnmarshalResult[[]int32]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewSliceSer[int32](), want, nil, t)
+		})
+
+	t.Run("Valid slice serializer should succeed", func(t *testing.T) {
+		sls := [][]uint16{{}, {0, 1, math.MaxUint16}}
+		test.Test(sls, NewValidSliceSer[uint16](), t)
+		test.TestSkip(sls, NewValidSliceSer[uint16](), t)
+	})
+
+	t.Run("If lenVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("lenVl error")
+				bs      = []byte{1, 1, 0}
+				lenVl   = cmock.NewValidator[int]().RegisterValidate(
+					func(v int) (err error) { return wantErr },
+				)
+				ser  = NewValidSliceSer(slopts.WithLenValidator[uint16](lenVl))
+				want = test.UnmarshalResult[[]uint16]{
+					N:   1,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{lenVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+
+	t.Run("If elemVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("elemVl error")
+				bs      = []byte{1, 1, 0}
+				elemVl  = cmock.NewValidator[uint16]().RegisterValidate(
+					func(v uint16) (err error) {
+						asserterror.Equal(t, v, 1)
+						return wantErr
+					},
+				)
+				ser  = NewValidSliceSer(slopts.WithElemValidator(elemVl))
+				want = test.UnmarshalResult[[]uint16]{
+					V:   []uint16{1},
+					N:   3,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{elemVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+}
+
+func testSlice[T raw.Numeric](t *testing.T, v []T) {
+	ser := NewSliceSer[T]()
+	test.Test([][]T{{}, v}, ser, t)
+	test.TestSkip([][]T{{}, v}, ser, t)
+}
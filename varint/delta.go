@@ -0,0 +1,266 @@
+package varint
+
+import (
+	"errors"
+	"time"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+	dltopts "github.com/mus-format/mus-go/options/delta"
+	"golang.org/x/exp/constraints"
+)
+
+// ErrUnsupportedTimeUnit means that a time unit is not one of time.Second,
+// time.Millisecond, time.Microsecond or time.Nanosecond.
+var ErrUnsupportedTimeUnit = errors.New(com.ErrorPrefix +
+	"unsupported time unit")
+
+// NewDeltaSliceSer returns a new integer slice serializer, which encodes a
+// slice as length + ZigZag Varint deltas between adjacent elements (the first
+// element is stored as a delta from zero). Sorted slices, such as ID lists,
+// take 1-2 bytes per element this way.
+//
+// To enable delta-of-delta encoding, use dltopts.WithDeltaOfDelta. To specify
+// a length or element validator, use NewValidDeltaSliceSer instead.
+func NewDeltaSliceSer[T constraints.Integer](opts ...dltopts.SetOption[T]) (
+	s deltaSliceSer[T],
+) {
+	o := dltopts.Options[T]{}
+	dltopts.Apply(opts, &o)
+
+	return newDeltaSliceSer(o, integerToUint64[T], uint64ToInteger[T])
+}
+
+// NewValidDeltaSliceSer returns a new valid integer delta slice serializer.
+func NewValidDeltaSliceSer[T constraints.Integer](
+	opts ...dltopts.SetOption[T],
+) validDeltaSliceSer[T] {
+	o := dltopts.Options[T]{}
+	dltopts.Apply(opts, &o)
+
+	return validDeltaSliceSer[T]{
+		deltaSliceSer: newDeltaSliceSer(o, integerToUint64[T],
+			uint64ToInteger[T]),
+		lenVl:  o.LenVl,
+		elemVl: o.ElemVl,
+	}
+}
+
+// NewDeltaTimeSliceSer returns a new time.Time slice serializer, which encodes
+// Unix timestamps of the given unit (time.Second, time.Millisecond,
+// time.Microsecond or time.Nanosecond) as ZigZag Varint deltas. The
+// deserialized values are in the local time zone.
+//
+// To enable delta-of-delta encoding, use dltopts.WithDeltaOfDelta. To specify
+// a length or element validator, use NewValidDeltaTimeSliceSer instead.
+//
+// Panics with ErrUnsupportedTimeUnit if the unit is not supported.
+func NewDeltaTimeSliceSer(unit time.Duration,
+	opts ...dltopts.SetOption[time.Time],
+) deltaSliceSer[time.Time] {
+	o := dltopts.Options[time.Time]{}
+	dltopts.Apply(opts, &o)
+
+	toUint64, fromUint64 := timeUint64Funcs(unit)
+	return newDeltaSliceSer(o, toUint64, fromUint64)
+}
+
+// NewValidDeltaTimeSliceSer returns a new valid time.Time delta slice
+// serializer.
+//
+// Panics with ErrUnsupportedTimeUnit if the unit is not supported.
+func NewValidDeltaTimeSliceSer(unit time.Duration,
+	opts ...dltopts.SetOption[time.Time],
+) validDeltaSliceSer[time.Time] {
+	o := dltopts.Options[time.Time]{}
+	dltopts.Apply(opts, &o)
+
+	toUint64, fromUint64 := timeUint64Funcs(unit)
+	return validDeltaSliceSer[time.Time]{
+		deltaSliceSer: newDeltaSliceSer(o, toUint64, fromUint64),
+		lenVl:         o.LenVl,
+		elemVl:        o.ElemVl,
+	}
+}
+
+func newDeltaSliceSer[T any](o dltopts.Options[T], toUint64 func(v T) uint64,
+	fromUint64 func(u uint64) T,
+) deltaSliceSer[T] {
+	var lenSer mus.Serializer[int] = PositiveInt
+	if o.LenSer != nil {
+		lenSer = o.LenSer
+	}
+	return deltaSliceSer[T]{
+		lenSer:       lenSer,
+		deltaOfDelta: o.DeltaOfDelta,
+		toUint64:     toUint64,
+		fromUint64:   fromUint64,
+	}
+}
+
+// Deltas are calculated with wraparound uint64 arithmetic, so any pair of
+// values, including the whole uint64 range, round-trips.
+type deltaSliceSer[T any] struct {
+	lenSer       mus.Serializer[int]
+	deltaOfDelta bool
+	toUint64     func(v T) uint64
+	fromUint64   func(u uint64) T
+}
+
+// Marshal fills bs with an encoded (Varint) slice value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s deltaSliceSer[T]) Marshal(v []T, bs []byte) (n int) {
+	n = s.lenSer.Marshal(len(v), bs)
+	var st deltaState
+	for i := range v {
+		n += marshalUint(st.encode(s.toUint64(v[i]), s.deltaOfDelta), bs[n:])
+	}
+	return
+}
+
+// Unmarshal parses an encoded (Varint) slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrOverflow, or
+// a length unmarshalling error.
+func (s deltaSliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	return s.unmarshal(bs, nil, nil)
+}
+
+// Size returns the size of an encoded (Varint) slice value.
+func (s deltaSliceSer[T]) Size(v []T) (size int) {
+	size = s.lenSer.Size(len(v))
+	var st deltaState
+	for i := range v {
+		size += sizeUint(st.encode(s.toUint64(v[i]), s.deltaOfDelta))
+	}
+	return
+}
+
+// Skip skips an encoded (Varint) slice value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrOverflow, or a
+// length unmarshalling error.
+func (s deltaSliceSer[T]) Skip(bs []byte) (n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	var n1 int
+	for range length {
+		n1, err = Uint64.Skip(bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (s deltaSliceSer[T]) unmarshal(bs []byte, lenVl com.Validator[int],
+	elemVl com.Validator[T],
+) (v []T, n int, err error) {
+	length, n, err := s.unmarshalLength(bs)
+	if err != nil {
+		return
+	}
+	if lenVl != nil {
+		if err = lenVl.Validate(length); err != nil {
+			return
+		}
+	}
+	var (
+		st deltaState
+		uv uint64
+		n1 int
+	)
+	v = make([]T, length)
+	for i := range v {
+		uv, n1, err = Uint64.Unmarshal(bs[n:])
+		n += n1
+		if err != nil {
+			return
+		}
+		v[i] = s.fromUint64(st.decode(uv, s.deltaOfDelta))
+		if elemVl != nil {
+			if err = elemVl.Validate(v[i]); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// unmarshalLength also checks that bs is long enough to hold length values,
+// each of which takes at least one byte.
+func (s deltaSliceSer[T]) unmarshalLength(bs []byte) (length, n int,
+	err error,
+) {
+	length, n, err = s.lenSer.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = com.ErrNegativeLength
+		return
+	}
+	if length > len(bs)-n {
+		err = mus.ErrTooSmallByteSlice
+	}
+	return
+}
+
+// valid -----------------------------------------------------------------------
+
+type validDeltaSliceSer[T any] struct {
+	deltaSliceSer[T]
+	lenVl  com.Validator[int]
+	elemVl com.Validator[T]
+}
+
+// Unmarshal parses an encoded (Varint) slice value from bs.
+//
+// In addition to the slice value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrNegativeLength, com.ErrOverflow, a
+// length unmarshalling error, or a length/element validation error.
+func (s validDeltaSliceSer[T]) Unmarshal(bs []byte) (v []T, n int, err error) {
+	return s.unmarshal(bs, s.lenVl, s.elemVl)
+}
+
+// -----------------------------------------------------------------------------
+
+type deltaState struct {
+	prev      uint64
+	prevDelta uint64
+}
+
+// encode returns the ZigZag encoded delta (or delta-of-delta) of u.
+func (st *deltaState) encode(u uint64, deltaOfDelta bool) uint64 {
+	d := u - st.prev
+	st.prev = u
+	if deltaOfDelta {
+		d, st.prevDelta = d-st.prevDelta, d
+	}
+	return uint64(EncodeZigZag(int64(d)))
+}
+
+// decode restores a value from the ZigZag encoded delta (or delta-of-delta).
+func (st *deltaState) decode(uv uint64, deltaOfDelta bool) uint64 {
+	d := DecodeZigZag(uv)
+	if deltaOfDelta {
+		d += st.prevDelta
+		st.prevDelta = d
+	}
+	st.prev += d
+	return st.prev
+}
+
+func integerToUint64[T constraints.Integer](v T) uint64 {
+	return uint64(v)
+}
+
+func uint64ToInteger[T constraints.Integer](u uint64) T {
+	return T(u)
+}
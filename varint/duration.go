@@ -0,0 +1,38 @@
+package varint
+
+import "time"
+
+// Duration is a time.Duration serializer that encodes a value as ZigZag Varint
+// nanoseconds.
+var Duration = durationSer{}
+
+type durationSer struct{}
+
+// Marshal fills bs with an encoded (Varint) time.Duration value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s durationSer) Marshal(v time.Duration, bs []byte) (n int) {
+	return Int64.Marshal(int64(v), bs)
+}
+
+// Unmarshal parses an encoded (Varint) time.Duration value from bs.
+//
+// In addition to the time.Duration value and the number of used bytes, it may
+// also return mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s durationSer) Unmarshal(bs []byte) (v time.Duration, n int, err error) {
+	d, n, err := Int64.Unmarshal(bs)
+	return time.Duration(d), n, err
+}
+
+// Size returns the size of an encoded (Varint) time.Duration value.
+func (s durationSer) Size(v time.Duration) (size int) {
+	return Int64.Size(int64(v))
+}
+
+// Skip skips an encoded (Varint) time.Duration value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s durationSer) Skip(bs []byte) (n int, err error) {
+	return Int64.Skip(bs)
+}
@@ -0,0 +1,161 @@
+package varint
+
+import (
+	"math/bits"
+
+	com "github.com/mus-format/common-go"
+	"github.com/mus-format/mus-go"
+)
+
+const (
+	// Uint128MaxVarintLen is the maximum length of an encoded (Varint) 128-bit
+	// integer.
+	Uint128MaxVarintLen = 19
+	uint128MaxLastByte  = 0x03
+)
+
+var (
+	// Uint128 is a 128-bit unsigned integer serializer. A value is represented
+	// as [2]uint64{hi, lo}. Values that fit uint64 are encoded the same way as
+	// by Uint64.
+	Uint128 = uint128Ser{}
+	// Int128 is a 128-bit signed (two's complement) integer serializer that
+	// uses ZigZag. A value is represented as [2]uint64{hi, lo}. Values that fit
+	// int64 are encoded the same way as by Int64.
+	Int128 = int128Ser{}
+)
+
+// uint128 ---------------------------------------------------------------------
+
+type uint128Ser struct{}
+
+// Marshal fills bs with an encoded (Varint) 128-bit unsigned integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s uint128Ser) Marshal(v [2]uint64, bs []byte) (n int) {
+	return marshalUint128(v[0], v[1], bs)
+}
+
+// Unmarshal parses an encoded (Varint) 128-bit unsigned integer value from
+// bs.
+//
+// In addition to the 128-bit integer value and the number of used bytes, it
+// may also return mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s uint128Ser) Unmarshal(bs []byte) (v [2]uint64, n int, err error) {
+	v[0], v[1], n, err = unmarshalUint128(bs)
+	return
+}
+
+// Size returns the size of an encoded (Varint) 128-bit unsigned integer value.
+func (s uint128Ser) Size(v [2]uint64) (size int) {
+	return sizeUint128(v[0], v[1])
+}
+
+// Skip skips an encoded (Varint) 128-bit unsigned integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s uint128Ser) Skip(bs []byte) (n int, err error) {
+	_, _, n, err = unmarshalUint128(bs)
+	return
+}
+
+// int128 ----------------------------------------------------------------------
+
+type int128Ser struct{}
+
+// Marshal fills bs with an encoded (Varint) 128-bit signed integer value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s int128Ser) Marshal(v [2]uint64, bs []byte) (n int) {
+	hi, lo := encodeZigZag128(v[0], v[1])
+	return marshalUint128(hi, lo, bs)
+}
+
+// Unmarshal parses an encoded (Varint) 128-bit signed integer value from bs.
+//
+// In addition to the 128-bit integer value and the number of used bytes, it
+// may also return mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s int128Ser) Unmarshal(bs []byte) (v [2]uint64, n int, err error) {
+	hi, lo, n, err := unmarshalUint128(bs)
+	if err != nil {
+		return
+	}
+	v[0], v[1] = decodeZigZag128(hi, lo)
+	return
+}
+
+// Size returns the size of an encoded (Varint) 128-bit signed integer value.
+func (s int128Ser) Size(v [2]uint64) (size int) {
+	return sizeUint128(encodeZigZag128(v[0], v[1]))
+}
+
+// Skip skips an encoded (Varint) 128-bit signed integer value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s int128Ser) Skip(bs []byte) (n int, err error) {
+	_, _, n, err = unmarshalUint128(bs)
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+func marshalUint128(hi, lo uint64, bs []byte) (n int) {
+	for hi != 0 || lo >= 0x80 {
+		bs[n] = byte(lo) | 0x80
+		lo = lo>>7 | hi<<57
+		hi >>= 7
+		n++
+	}
+	bs[n] = byte(lo)
+	return n + 1
+}
+
+func unmarshalUint128(bs []byte) (hi, lo uint64, n int, err error) {
+	if len(bs) == 0 {
+		err = mus.ErrTooSmallByteSlice
+		return
+	}
+	var (
+		b     byte
+		shift uint
+	)
+	for n, b = range bs {
+		n++
+		if n == Uint128MaxVarintLen && b > uint128MaxLastByte {
+			return 0, 0, n, com.ErrOverflow
+		}
+		g := uint64(b & 0x7f)
+		if shift < 64 {
+			lo |= g << shift
+			if shift > 57 {
+				hi |= g >> (64 - shift)
+			}
+		} else {
+			hi |= g << (shift - 64)
+		}
+		if b < 0x80 {
+			return
+		}
+		shift += 7
+	}
+	return 0, 0, n, mus.ErrTooSmallByteSlice
+}
+
+func sizeUint128(hi, lo uint64) (size int) {
+	if hi == 0 {
+		return sizeUint(lo)
+	}
+	return (64 + bits.Len64(hi) + 6) / 7
+}
+
+func encodeZigZag128(hi, lo uint64) (zhi, zlo uint64) {
+	m := uint64(int64(hi) >> 63)
+	return (hi<<1 | lo>>63) ^ m, lo<<1 ^ m
+}
+
+func decodeZigZag128(zhi, zlo uint64) (hi, lo uint64) {
+	m := -(zlo & 1)
+	return zhi>>1 ^ m, (zlo>>1 | zhi<<63) ^ m
+}
@@ -0,0 +1,212 @@
+package varint
+
+import (
+	"time"
+
+	com "github.com/mus-format/common-go"
+	tmopts "github.com/mus-format/mus-go/options/time"
+)
+
+var (
+	// TimeUnix is a time.Time serializer that encodes a value as a ZigZag
+	// Varint Unix timestamp in seconds.
+	TimeUnix = NewTimeSer(time.Second)
+	// TimeUnixMilli is a time.Time serializer that encodes a value as a ZigZag
+	// Varint Unix timestamp in milliseconds.
+	TimeUnixMilli = NewTimeSer(time.Millisecond)
+	// TimeUnixMicro is a time.Time serializer that encodes a value as a ZigZag
+	// Varint Unix timestamp in microseconds.
+	TimeUnixMicro = NewTimeSer(time.Microsecond)
+	// TimeUnixNano is a time.Time serializer that encodes a value as a ZigZag
+	// Varint Unix timestamp in nanoseconds.
+	TimeUnixNano = NewTimeSer(time.Nanosecond)
+
+	// TimeUnixUTC is a time.Time serializer that encodes a value as a ZigZag
+	// Varint Unix timestamp in seconds. The deserialized value is always in
+	// UTC.
+	TimeUnixUTC = NewTimeSer(time.Second, tmopts.WithUTC())
+	// TimeUnixMilliUTC is a time.Time serializer that encodes a value as a
+	// ZigZag Varint Unix timestamp in milliseconds. The deserialized value is
+	// always in UTC.
+	TimeUnixMilliUTC = NewTimeSer(time.Millisecond, tmopts.WithUTC())
+	// TimeUnixMicroUTC is a time.Time serializer that encodes a value as a
+	// ZigZag Varint Unix timestamp in microseconds. The deserialized value is
+	// always in UTC.
+	TimeUnixMicroUTC = NewTimeSer(time.Microsecond, tmopts.WithUTC())
+	// TimeUnixNanoUTC is a time.Time serializer that encodes a value as a
+	// ZigZag Varint Unix timestamp in nanoseconds. The deserialized value is
+	// always in UTC.
+	TimeUnixNanoUTC = NewTimeSer(time.Nanosecond, tmopts.WithUTC())
+
+	// Time is a time.Time serializer that encodes a value as ZigZag Varint Unix
+	// seconds + Varint nanoseconds. Unlike TimeUnixNano, it covers the whole
+	// time.Time range, including the zero time, without losing precision.
+	Time = timeFullSer{}
+	// TimeUTC is a time.Time serializer that encodes a value as ZigZag Varint
+	// Unix seconds + Varint nanoseconds. The deserialized value is always in
+	// UTC.
+	TimeUTC = timeFullSer{utc: true}
+)
+
+// NewTimeSer returns a new time.Time serializer, which encodes a value as a
+// ZigZag Varint timestamp of the given unit (time.Second, time.Millisecond,
+// time.Microsecond or time.Nanosecond).
+//
+// By default, timestamps are relative to the Unix epoch and the deserialized
+// values are in the local time zone. To change this, use tmopts.WithEpoch and
+// tmopts.WithUTC.
+//
+// Panics with ErrUnsupportedTimeUnit if the unit is not supported.
+func NewTimeSer(unit time.Duration, opts ...tmopts.SetOption) timeSer {
+	o := tmopts.Options{}
+	tmopts.Apply(opts, &o)
+
+	toUint64, fromUint64 := timeUint64Funcs(unit)
+	var epoch uint64
+	if !o.Epoch.IsZero() {
+		epoch = toUint64(o.Epoch)
+	}
+	return timeSer{
+		toUint64:   toUint64,
+		fromUint64: fromUint64,
+		epoch:      epoch,
+		utc:        o.UTC,
+	}
+}
+
+type timeSer struct {
+	toUint64   func(v time.Time) uint64
+	fromUint64 func(u uint64) time.Time
+	epoch      uint64
+	utc        bool
+}
+
+// Marshal fills bs with an encoded (Varint) time.Time value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s timeSer) Marshal(v time.Time, bs []byte) (n int) {
+	return Int64.Marshal(s.timestamp(v), bs)
+}
+
+// Unmarshal parses an encoded (Varint) time.Time value from bs.
+//
+// In addition to the time.Time value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s timeSer) Unmarshal(bs []byte) (v time.Time, n int, err error) {
+	ts, n, err := Int64.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	v = s.fromUint64(uint64(ts) + s.epoch)
+	if s.utc {
+		v = v.UTC()
+	}
+	return
+}
+
+// Size returns the size of an encoded (Varint) time.Time value.
+func (s timeSer) Size(v time.Time) (size int) {
+	return Int64.Size(s.timestamp(v))
+}
+
+// Skip skips an encoded (Varint) time.Time value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice or com.ErrOverflow.
+func (s timeSer) Skip(bs []byte) (n int, err error) {
+	return Int64.Skip(bs)
+}
+
+// timestamp returns the timestamp of v relative to the epoch.
+func (s timeSer) timestamp(v time.Time) int64 {
+	return int64(s.toUint64(v) - s.epoch)
+}
+
+// full precision --------------------------------------------------------------
+
+type timeFullSer struct {
+	utc bool
+}
+
+// Marshal fills bs with an encoded (Varint) time.Time value.
+//
+// Returns the number of used bytes. It will panic if receives too small bs.
+func (s timeFullSer) Marshal(v time.Time, bs []byte) (n int) {
+	n = Int64.Marshal(v.Unix(), bs)
+	return n + Uint32.Marshal(uint32(v.Nanosecond()), bs[n:])
+}
+
+// Unmarshal parses an encoded (Varint) time.Time value from bs.
+//
+// In addition to the time.Time value and the number of used bytes, it may also
+// return mus.ErrTooSmallByteSlice, com.ErrOverflow or com.ErrWrongFormat, if
+// nanoseconds are out of range.
+func (s timeFullSer) Unmarshal(bs []byte) (v time.Time, n int, err error) {
+	sec, n, err := Int64.Unmarshal(bs)
+	if err != nil {
+		return
+	}
+	nsec, n1, err := Uint32.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if nsec > 999999999 {
+		err = com.ErrWrongFormat
+		return
+	}
+	v = time.Unix(sec, int64(nsec))
+	if s.utc {
+		v = v.UTC()
+	}
+	return
+}
+
+// Size returns the size of an encoded (Varint) time.Time value.
+func (s timeFullSer) Size(v time.Time) (size int) {
+	return Int64.Size(v.Unix()) + Uint32.Size(uint32(v.Nanosecond()))
+}
+
+// Skip skips an encoded (Varint) time.Time value.
+//
+// In addition to the number of skipped bytes, it may also return
+// mus.ErrTooSmallByteSlice, com.ErrOverflow or com.ErrWrongFormat, if the
+// nanoseconds are out of range.
+func (s timeFullSer) Skip(bs []byte) (n int, err error) {
+	if n, err = Int64.Skip(bs); err != nil {
+		return
+	}
+	nsec, n1, err := Uint32.Unmarshal(bs[n:])
+	n += n1
+	if err != nil {
+		return
+	}
+	if nsec > 999999999 {
+		err = com.ErrWrongFormat
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+func timeUint64Funcs(unit time.Duration) (toUint64 func(v time.Time) uint64,
+	fromUint64 func(u uint64) time.Time,
+) {
+	switch unit {
+	case time.Second:
+		toUint64 = func(v time.Time) uint64 { return uint64(v.Unix()) }
+		fromUint64 = func(u uint64) time.Time { return time.Unix(int64(u), 0) }
+	case time.Millisecond:
+		toUint64 = func(v time.Time) uint64 { return uint64(v.UnixMilli()) }
+		fromUint64 = func(u uint64) time.Time { return time.UnixMilli(int64(u)) }
+	case time.Microsecond:
+		toUint64 = func(v time.Time) uint64 { return uint64(v.UnixMicro()) }
+		fromUint64 = func(u uint64) time.Time { return time.UnixMicro(int64(u)) }
+	case time.Nanosecond:
+		toUint64 = func(v time.Time) uint64 { return uint64(v.UnixNano()) }
+		fromUint64 = func(u uint64) time.Time { return time.Unix(0, int64(u)) }
+	default:
+		panic(ErrUnsupportedTimeUnit)
+	}
+	return
+}
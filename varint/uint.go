@@ -239,13 +239,13 @@ func skipUint(maxVarintLen int, maxLastByte byte, bs []byte) (n int,
 	}
 	var b byte
 	for n, b = range bs {
+		n++
 		if n == maxVarintLen && b > maxLastByte {
 			return n, com.ErrOverflow
 		}
 		if b < 0x80 {
-			n++
 			return
 		}
 	}
-	return n + 1, mus.ErrTooSmallByteSlice
+	return n, mus.ErrTooSmallByteSlice
 }
@@ -3,7 +3,10 @@ package varint
 import (
 	"math"
 	"testing"
+	"time"
 
+	dltopts "github.com/mus-format/mus-go/options/delta"
+	tmopts "github.com/mus-format/mus-go/options/time"
 	"github.com/mus-format/mus-go/test"
 )
 
@@ -249,6 +252,46 @@ func FuzzVarint_Float32Unmarshal(f *testing.F) {
 	})
 }
 
+// complex128 ------------------------------------------------------------------
+
+func FuzzVarint_Complex128(f *testing.F) {
+	seeds := []float64{0, 1, -1, 3.14}
+	for _, seed := range seeds {
+		f.Add(seed, -seed)
+	}
+	f.Fuzz(func(t *testing.T, re, im float64) {
+		test.Test([]complex128{complex(re, im)}, Complex128, t)
+		test.TestSkip([]complex128{complex(re, im)}, Complex128, t)
+	})
+}
+
+func FuzzVarint_Complex128Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Complex128.Unmarshal(bs)
+		Complex128.Skip(bs)
+	})
+}
+
+// complex64 -------------------------------------------------------------------
+
+func FuzzVarint_Complex64(f *testing.F) {
+	seeds := []float32{0, 1, -1, 3.14}
+	for _, seed := range seeds {
+		f.Add(seed, -seed)
+	}
+	f.Fuzz(func(t *testing.T, re, im float32) {
+		test.Test([]complex64{complex(re, im)}, Complex64, t)
+		test.TestSkip([]complex64{complex(re, im)}, Complex64, t)
+	})
+}
+
+func FuzzVarint_Complex64Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Complex64.Unmarshal(bs)
+		Complex64.Skip(bs)
+	})
+}
+
 // positive_int ----------------------------------------------------------------
 
 func FuzzVarint_PositiveInt64(f *testing.F) {
@@ -355,3 +398,89 @@ func FuzzVarint_PositiveIntUnmarshal(f *testing.F) {
 		PositiveInt.Skip(bs)
 	})
 }
+
+// delta slice -----------------------------------------------------------------
+
+func FuzzVarint_DeltaSlice(f *testing.F) {
+	f.Fuzz(func(t *testing.T, a, b, c int64, deltaOfDelta bool) {
+		var opts []dltopts.SetOption[int64]
+		if deltaOfDelta {
+			opts = append(opts, dltopts.WithDeltaOfDelta[int64]())
+		}
+		ser := NewDeltaSliceSer(opts...)
+		test.Test([][]int64{{a, b, c}}, ser, t)
+		test.TestSkip([][]int64{{a, b, c}}, ser, t)
+	})
+}
+
+func FuzzVarint_DeltaSliceUnmarshal(f *testing.F) {
+	ser := NewDeltaSliceSer(dltopts.WithDeltaOfDelta[uint64]())
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		ser.Unmarshal(bs)
+		ser.Skip(bs)
+	})
+}
+
+// time ------------------------------------------------------------------------
+
+func FuzzVarint_Time(f *testing.F) {
+	var (
+		epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		ser   = NewTimeSer(time.Second, tmopts.WithEpoch(epoch))
+	)
+	f.Fuzz(func(t *testing.T, sec int64, nano int64) {
+		test.Test([]time.Time{time.Unix(sec, 0)}, TimeUnix, t)
+		test.TestSkip([]time.Time{time.Unix(sec, 0)}, TimeUnix, t)
+		test.Test([]time.Time{time.Unix(0, nano)}, TimeUnixNanoUTC, t)
+		test.Test([]time.Time{time.Unix(sec%(1<<40), 0)}, ser, t)
+		test.Test([]time.Time{time.Unix(sec, nano)}, Time, t)
+		test.TestSkip([]time.Time{time.Unix(sec, nano)}, TimeUTC, t)
+	})
+}
+
+func FuzzVarint_TimeUnmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		TimeUnix.Unmarshal(bs)
+		TimeUnix.Skip(bs)
+		TimeUnixNanoUTC.Unmarshal(bs)
+		Time.Unmarshal(bs)
+		Time.Skip(bs)
+	})
+}
+
+// int128 ----------------------------------------------------------------------
+
+func FuzzVarint_Int128(f *testing.F) {
+	f.Fuzz(func(t *testing.T, hi, lo uint64) {
+		v := [2]uint64{hi, lo}
+		test.Test([][2]uint64{v}, Uint128, t)
+		test.TestSkip([][2]uint64{v}, Uint128, t)
+		test.Test([][2]uint64{v}, Int128, t)
+		test.TestSkip([][2]uint64{v}, Int128, t)
+	})
+}
+
+func FuzzVarint_Int128Unmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Uint128.Unmarshal(bs)
+		Uint128.Skip(bs)
+		Int128.Unmarshal(bs)
+		Int128.Skip(bs)
+	})
+}
+
+// duration --------------------------------------------------------------------
+
+func FuzzVarint_Duration(f *testing.F) {
+	f.Fuzz(func(t *testing.T, v int64) {
+		test.Test([]time.Duration{time.Duration(v)}, Duration, t)
+		test.TestSkip([]time.Duration{time.Duration(v)}, Duration, t)
+	})
+}
+
+func FuzzVarint_DurationUnmarshal(f *testing.F) {
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		Duration.Unmarshal(bs)
+		Duration.Skip(bs)
+	})
+}
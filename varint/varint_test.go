@@ -1,13 +1,21 @@
 package varint
 
 import (
+	"bytes"
+	"errors"
+	"math"
 	"testing"
+	"time"
 
 	com "github.com/mus-format/common-go"
 	ctest "github.com/mus-format/common-go/test"
+	cmock "github.com/mus-format/common-go/test/mock"
 	"github.com/mus-format/mus-go"
+	dltopts "github.com/mus-format/mus-go/options/delta"
+	tmopts "github.com/mus-format/mus-go/options/time"
 	"github.com/mus-format/mus-go/test"
 	asserterror "github.com/ymz-ncnk/assert/error"
+	"github.com/ymz-ncnk/mok"
 )
 
 func TestVarint_unmarshalUint(t *testing.T) {
@@ -92,6 +100,21 @@ func TestVarint_skipUint(t *testing.T) {
 			asserterror.EqualError(t, err, want.Err)
 		})
 
+	t.Run("skipUint should return ErrOverflow if the last byte is too large",
+		func(t *testing.T) {
+			var (
+				want = test.SkipResult{
+					N:   3,
+					Err: com.ErrOverflow,
+				}
+				bs = []byte{0xff, 0xff, 0x7f}
+			)
+			n, err := skipUint(com.Uint16MaxVarintLen, com.Uint16MaxLastByte,
+				bs)
+			asserterror.Equal(t, want.N, n)
+			asserterror.EqualError(t, err, want.Err)
+		})
+
 	t.Run("skipUint shold return ErrTooSmallByteSlice if there is no space in bs",
 		func(t *testing.T) {
 			var (
@@ -407,3 +430,490 @@ func TestVarint_Float32(t *testing.T) {
 			test.TestUnmarshalOnly(bs, Float32, want, nil, t)
 		})
 }
+
+func TestVarint_Complex128(t *testing.T) {
+	t.Run("Complex128 serializer should succeed", func(t *testing.T) {
+		cases := []complex128{0, 1 + 2i, -3.5 - 0.25i,
+			complex(math.MaxFloat64, math.SmallestNonzeroFloat64),
+			complex(math.Inf(1), math.NaN())}
+		test.Test(cases, Complex128, t)
+		test.TestSkip(cases, Complex128, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no imaginary part",
+		func(t *testing.T) {
+			var (
+				v    = complex(1.5, 2.5)
+				bs   = make([]byte, Complex128.Size(v))
+				want = test.UnmarshalResult[complex128]{
+					N:   10,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			Complex128.Marshal(v, bs)
+			bs = bs[:Float64.Size(real(v))+1]
+			test.TestUnmarshalOnly(bs, Complex128, want, nil, t)
+			n, err := Complex128.Skip(bs)
+			asserterror.Equal(t, n, want.N)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestVarint_Complex64(t *testing.T) {
+	t.Run("Complex64 serializer should succeed", func(t *testing.T) {
+		cases := []complex64{0, 1 + 2i, -3.5 - 0.25i,
+			complex(math.MaxFloat32, math.SmallestNonzeroFloat32),
+			complex(float32(math.Inf(-1)), float32(math.NaN()))}
+		test.Test(cases, Complex64, t)
+		test.TestSkip(cases, Complex64, t)
+	})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no imaginary part",
+		func(t *testing.T) {
+			var (
+				v    = complex64(complex(1.5, 2.5))
+				bs   = make([]byte, Complex64.Size(v))
+				want = test.UnmarshalResult[complex64]{
+					N:   6,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			Complex64.Marshal(v, bs)
+			bs = bs[:Float32.Size(real(v))+1]
+			test.TestUnmarshalOnly(bs, Complex64, want, nil, t)
+			n, err := Complex64.Skip(bs)
+			asserterror.Equal(t, n, want.N)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}
+
+func TestVarint_Int128(t *testing.T) {
+	t.Run("Uint128 and Int128 serializers should succeed", func(t *testing.T) {
+		cases := [][2]uint64{{0, 0}, {0, 1}, {0, 1 << 63}, {1, 0},
+			{math.MaxUint64, math.MaxUint64}, {1 << 63, 0}, {math.MaxUint64, 0},
+			{1 << 63 >> 1, math.MaxUint64}}
+		test.Test(cases, Uint128, t)
+		test.TestSkip(cases, Uint128, t)
+		test.Test(cases, Int128, t)
+		test.TestSkip(cases, Int128, t)
+	})
+
+	t.Run("Uint128 should be compatible with Uint64", func(t *testing.T) {
+		for _, v := range ctest.Uint64TestCases {
+			var (
+				bs   = make([]byte, Uint64.Size(v))
+				wbs  = make([]byte, Uint128.Size([2]uint64{0, v}))
+				want = [2]uint64{0, v}
+			)
+			Uint64.Marshal(v, bs)
+			Uint128.Marshal(want, wbs)
+			asserterror.EqualDeep(t, wbs, bs)
+		}
+	})
+
+	t.Run("Int128 should be compatible with Int64", func(t *testing.T) {
+		for _, v := range ctest.Int64TestCases {
+			var (
+				bs   = make([]byte, Int64.Size(v))
+				want = [2]uint64{uint64(v >> 63), uint64(v)}
+				wbs  = make([]byte, Int128.Size(want))
+			)
+			Int64.Marshal(v, bs)
+			Int128.Marshal(want, wbs)
+			asserterror.EqualDeep(t, wbs, bs)
+		}
+	})
+
+	t.Run("Max Uint128 value should take Uint128MaxVarintLen bytes",
+		func(t *testing.T) {
+			v := [2]uint64{math.MaxUint64, math.MaxUint64}
+			asserterror.Equal(t, Uint128.Size(v), Uint128MaxVarintLen)
+		})
+
+	t.Run("Unmarshal should return ErrOverflow if the value does not fit 128 bits",
+		func(t *testing.T) {
+			var (
+				bs   = append(bytes.Repeat([]byte{0xff}, 18), 0x04)
+				want = test.UnmarshalResult[[2]uint64]{
+					N:   19,
+					Err: com.ErrOverflow,
+				}
+			)
+			test.TestUnmarshalOnly(bs, Uint128, want, nil, t)
+			test.TestUnmarshalOnly(bs, Int128, want, nil, t)
+			n, err := Uint128.Skip(bs)
+			asserterror.Equal(t, n, 19)
+			asserterror.EqualError(t, err, com.ErrOverflow)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if there is no varint end",
+		func(t *testing.T) {
+			for _, bs := range [][]byte{{}, {0x80, 0x80}} {
+				want := test.UnmarshalResult[[2]uint64]{
+					N:   len(bs),
+					Err: mus.ErrTooSmallByteSlice,
+				}
+				test.TestUnmarshalOnly(bs, Uint128, want, nil, t)
+				test.TestUnmarshalOnly(bs, Int128, want, nil, t)
+			}
+		})
+}
+
+func TestVarint_Duration(t *testing.T) {
+	t.Run("Duration serializer should succeed", func(t *testing.T) {
+		cases := []time.Duration{0, time.Nanosecond, -time.Hour,
+			math.MaxInt64, math.MinInt64}
+		test.Test(cases, Duration, t)
+		test.TestSkip(cases, Duration, t)
+	})
+
+	t.Run("Duration should be encoded as ZigZag Varint", func(t *testing.T) {
+		var (
+			v      = -time.Duration(2)
+			wantBS = []byte{3}
+			bs     = make([]byte, Duration.Size(v))
+		)
+		Duration.Marshal(v, bs)
+		asserterror.EqualDeep(t, bs, wantBS)
+	})
+}
+
+func TestVarint_DeltaSlice(t *testing.T) {
+	t.Run("Delta slice serializer should succeed", func(t *testing.T) {
+		var (
+			i64s = [][]int64{
+				{},
+				{100, 101, 105, 90},
+				{math.MinInt64, math.MaxInt64, 0, math.MinInt64},
+			}
+			u64s = [][]uint64{
+				{},
+				{1, 2, 3},
+				{math.MaxUint64, 0, math.MaxUint64},
+			}
+		)
+		test.Test(i64s, NewDeltaSliceSer[int64](), t)
+		test.TestSkip(i64s, NewDeltaSliceSer[int64](), t)
+		test.Test(u64s, NewDeltaSliceSer[uint64](), t)
+		test.TestSkip(u64s, NewDeltaSliceSer[uint64](), t)
+		test.Test([][]int8{{math.MinInt8, math.MaxInt8, -1}},
+			NewDeltaSliceSer[int8](), t)
+	})
+
+	t.Run("Delta-of-delta slice serializer should succeed", func(t *testing.T) {
+		var (
+			i64s = [][]int64{
+				{},
+				{1000, 1010, 1020, 1031, 1040},
+				{math.MinInt64, math.MaxInt64, 0, math.MinInt64},
+			}
+			ser = NewDeltaSliceSer(dltopts.WithDeltaOfDelta[int64]())
+		)
+		test.Test(i64s, ser, t)
+		test.TestSkip(i64s, ser, t)
+	})
+
+	t.Run("Marshal should encode deltas", func(t *testing.T) {
+		var (
+			v      = []int64{1000, 1001, 1003, 1002}
+			wantBs = []byte{4, 0xD0, 0x0F, 2, 4, 1}
+			ser    = NewDeltaSliceSer[int64]()
+			bs     = make([]byte, ser.Size(v))
+		)
+		ser.Marshal(v, bs)
+		asserterror.EqualDeep(t, bs, wantBs)
+	})
+
+	t.Run("Marshal should encode deltas of deltas", func(t *testing.T) {
+		var (
+			v      = []int64{1000, 1010, 1020, 1031}
+			wantBs = []byte{4, 0xD0, 0x0F, 0xBB, 0x0F, 0, 2}
+			ser    = NewDeltaSliceSer(dltopts.WithDeltaOfDelta[int64]())
+			bs     = make([]byte, ser.Size(v))
+		)
+		ser.Marshal(v, bs)
+		asserterror.EqualDeep(t, bs, wantBs)
+	})
+
+	t.Run("Unmarshal should return ErrNegativeLength if meets a negative length",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1}
+				want = test.UnmarshalResult[[]int64]{
+					N:   1,
+					Err: com.ErrNegativeLength,
+				}
+				ser = NewDeltaSliceSer(dltopts.WithLenSer[int64](Int))
+			)
+			test.TestUnmarshalOnly(bs, ser, want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if the length is too large",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{3, 1, 1}
+				want = test.UnmarshalResult[[]int64]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewDeltaSliceSer[int64](), want, nil, t)
+		})
+
+	t.Run("Unmarshal should return ErrOverflow if meets an invalid delta",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{1, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x7F}
+				want = test.UnmarshalResult[[]int64]{
+					V:   []int64{0},
+					N:   11,
+					Err: com.ErrOverflow,
+				}
+			)
+			test.TestUnmarshalOnly(bs, NewDeltaSliceSer[int64](), want, nil, t)
+		})
+
+	t.Run("Skip should return ErrTooSmallByteSlice if there is no space in bs",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{2, 1, 0x80}
+				want = test.SkipResult{
+					N:   3,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestSkipOnly(bs, NewDeltaSliceSer[int64](), want, nil, t)
+		})
+
+	t.Run("If lenVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("lenVl error")
+				bs      = []byte{1, 2}
+				lenVl   = cmock.NewValidator[int]().RegisterValidate(
+					func(v int) (err error) { return wantErr },
+				)
+				ser  = NewValidDeltaSliceSer(dltopts.WithLenValidator[uint64](lenVl))
+				want = test.UnmarshalResult[[]uint64]{
+					N:   1,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{lenVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+
+	t.Run("If elemVl returns an error, valid Unmarshal should return it",
+		func(t *testing.T) {
+			var (
+				wantErr = errors.New("elemVl error")
+				bs      = []byte{2, 2, 2}
+				elemVl  = cmock.NewValidator[uint64]().RegisterValidate(
+					func(v uint64) (err error) {
+						asserterror.Equal(t, v, 1)
+						return wantErr
+					},
+				)
+				ser  = NewValidDeltaSliceSer(dltopts.WithElemValidator(elemVl))
+				want = test.UnmarshalResult[[]uint64]{
+					V:   []uint64{1, 0},
+					N:   2,
+					Err: wantErr,
+				}
+				mocks = []*mok.Mock{elemVl.Mock}
+			)
+			test.TestUnmarshalOnly(bs, ser, want, mocks, t)
+		})
+}
+
+func TestVarint_DeltaTimeSlice(t *testing.T) {
+	t.Run("Delta time slice serializer should succeed for all units",
+		func(t *testing.T) {
+			var (
+				sec   = []time.Time{time.Unix(1700000000, 0), time.Unix(1700000001, 0), time.Unix(1600000000, 0)}
+				milli = []time.Time{time.UnixMilli(1700000000000), time.UnixMilli(1700000000250)}
+				micro = []time.Time{time.UnixMicro(1700000000000000), time.UnixMicro(-1)}
+				nano  = []time.Time{time.Unix(0, 1700000000000000000), time.Unix(0, 1700000000000000001)}
+			)
+			test.Test([][]time.Time{{}, sec}, NewDeltaTimeSliceSer(time.Second), t)
+			test.Test([][]time.Time{milli}, NewDeltaTimeSliceSer(time.Millisecond), t)
+			test.Test([][]time.Time{micro}, NewDeltaTimeSliceSer(time.Microsecond), t)
+			test.Test([][]time.Time{nano}, NewDeltaTimeSliceSer(time.Nanosecond), t)
+			test.TestSkip([][]time.Time{{}, sec}, NewDeltaTimeSliceSer(time.Second), t)
+		})
+
+	t.Run("Regular timestamps should take 1 byte each with delta-of-delta encoding",
+		func(t *testing.T) {
+			var (
+				v   = make([]time.Time, 100)
+				ser = NewDeltaTimeSliceSer(time.Second,
+					dltopts.WithDeltaOfDelta[time.Time]())
+			)
+			for i := range v {
+				v[i] = time.Unix(1700000000+int64(i)*15, 0)
+			}
+			// length + first value + first delta-of-delta + 98 zeros.
+			asserterror.Equal(t, ser.Size(v), 1+5+5+98)
+			test.Test([][]time.Time{v}, ser, t)
+			test.TestSkip([][]time.Time{v}, ser, t)
+		})
+
+	t.Run("Valid delta time slice serializer should succeed", func(t *testing.T) {
+		var (
+			v   = []time.Time{time.UnixMilli(1700000000000), time.UnixMilli(1700000000250)}
+			ser = NewValidDeltaTimeSliceSer(time.Millisecond)
+		)
+		test.Test([][]time.Time{v}, ser, t)
+		test.TestSkip([][]time.Time{v}, ser, t)
+	})
+
+	t.Run("NewDeltaTimeSliceSer should panic with ErrUnsupportedTimeUnit if the unit is not supported",
+		func(t *testing.T) {
+			wantErr := ErrUnsupportedTimeUnit
+			defer func() {
+				if r := recover(); r != wantErr {
+					t.Errorf("unexpected error, want '%v' actual '%v'", wantErr, r)
+				}
+			}()
+			NewDeltaTimeSliceSer(time.Minute)
+		})
+}
+
+func TestVarint_Time(t *testing.T) {
+	t.Run("Time serializers should succeed", func(t *testing.T) {
+		now := time.Now()
+		for _, c := range []struct {
+			ser mus.Serializer[time.Time]
+			v   time.Time
+		}{
+			{TimeUnix, time.Unix(now.Unix(), 0)},
+			{TimeUnix, time.Unix(-1000, 0)},
+			{TimeUnixMilli, time.UnixMilli(now.UnixMilli())},
+			{TimeUnixMicro, time.UnixMicro(now.UnixMicro())},
+			{TimeUnixNano, now},
+			{TimeUnixUTC, time.Unix(now.Unix(), 0).UTC()},
+			{TimeUnixMilliUTC, time.UnixMilli(now.UnixMilli()).UTC()},
+			{TimeUnixMicroUTC, time.UnixMicro(now.UnixMicro()).UTC()},
+			{TimeUnixNanoUTC, now.UTC()},
+		} {
+			test.Test([]time.Time{c.v}, c.ser, t)
+			test.TestSkip([]time.Time{c.v}, c.ser, t)
+		}
+	})
+
+	t.Run("UTC serializers should return values in UTC", func(t *testing.T) {
+		var (
+			v  = time.Unix(1700000000, 0).In(time.FixedZone("", 3600))
+			bs = make([]byte, TimeUnixUTC.Size(v))
+		)
+		TimeUnixUTC.Marshal(v, bs)
+		av, _, err := TimeUnixUTC.Unmarshal(bs)
+		asserterror.EqualError(t, err, nil)
+		asserterror.Equal(t, av.Location(), time.UTC)
+	})
+
+	t.Run("Timestamps should be encoded relative to the custom epoch",
+		func(t *testing.T) {
+			var (
+				epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				ser   = NewTimeSer(time.Second, tmopts.WithEpoch(epoch),
+					tmopts.WithUTC())
+				v      = epoch.Add(-2 * time.Second)
+				wantBS = []byte{3}
+				bs     = make([]byte, ser.Size(v))
+			)
+			ser.Marshal(v, bs)
+			asserterror.EqualDeep(t, bs, wantBS)
+			test.Test([]time.Time{v}, ser, t)
+			test.Test([]time.Time{epoch.Add(100 * 24 * time.Hour)}, ser, t)
+			asserterror.Equal(t, ser.Size(epoch.AddDate(1, 0, 0)), 4)
+		})
+
+	t.Run("NewTimeSer should panic with ErrUnsupportedTimeUnit if the unit is not supported",
+		func(t *testing.T) {
+			defer func() {
+				asserterror.Equal[any](t, recover(), ErrUnsupportedTimeUnit)
+			}()
+			NewTimeSer(time.Minute)
+		})
+
+	t.Run("Unmarshal should return ErrTooSmallByteSlice if bs is empty",
+		func(t *testing.T) {
+			var (
+				want = test.UnmarshalResult[time.Time]{
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly([]byte{}, TimeUnix, want, nil, t)
+		})
+
+	t.Run("Full-precision time serializers should succeed", func(t *testing.T) {
+		for _, c := range []struct {
+			ser mus.Serializer[time.Time]
+			v   time.Time
+		}{
+			{Time, time.Now()},
+			{Time, time.Time{}},
+			{Time, time.Date(1200, 3, 4, 5, 6, 7, 123456789, time.UTC)},
+			{Time, time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+			{Time, time.Date(-500, 1, 1, 0, 0, 0, 1, time.UTC)},
+			{TimeUTC, time.Now().UTC()},
+			{TimeUTC, time.Date(100000, 1, 1, 0, 0, 0, 42, time.UTC)},
+		} {
+			test.Test([]time.Time{c.v}, c.ser, t)
+			test.TestSkip([]time.Time{c.v}, c.ser, t)
+		}
+	})
+
+	t.Run("TimeUTC should round-trip the zero time exactly", func(t *testing.T) {
+		var (
+			v  = time.Time{}
+			bs = make([]byte, TimeUTC.Size(v))
+		)
+		TimeUTC.Marshal(v, bs)
+		av, _, err := TimeUTC.Unmarshal(bs)
+		asserterror.EqualError(t, err, nil)
+		asserterror.Equal(t, av, v)
+		asserterror.Equal(t, av.IsZero(), true)
+	})
+
+	t.Run("Time should be compatible with the Int64 + Uint32 encoding",
+		func(t *testing.T) {
+			var (
+				v      = time.Unix(-2, 5)
+				wantBS = []byte{3, 5}
+				bs     = make([]byte, Time.Size(v))
+			)
+			Time.Marshal(v, bs)
+			asserterror.EqualDeep(t, bs, wantBS)
+		})
+
+	t.Run("Time.Unmarshal and Time.Skip should return ErrWrongFormat if nanoseconds are out of range",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{0, 0x80, 0x94, 0xeb, 0xdc, 0x03}
+				want = test.UnmarshalResult[time.Time]{
+					N:   6,
+					Err: com.ErrWrongFormat,
+				}
+			)
+			test.TestUnmarshalOnly(bs, Time, want, nil, t)
+			test.TestSkipOnly(bs, Time,
+				test.SkipResult{N: 6, Err: com.ErrWrongFormat}, nil, t)
+		})
+
+	t.Run("Time.Unmarshal should return ErrTooSmallByteSlice if there is no nanoseconds",
+		func(t *testing.T) {
+			var (
+				bs   = []byte{2}
+				want = test.UnmarshalResult[time.Time]{
+					N:   1,
+					Err: mus.ErrTooSmallByteSlice,
+				}
+			)
+			test.TestUnmarshalOnly(bs, Time, want, nil, t)
+			n, err := Time.Skip(bs)
+			asserterror.Equal(t, n, 1)
+			asserterror.EqualError(t, err, mus.ErrTooSmallByteSlice)
+		})
+}